@@ -0,0 +1,73 @@
+package dbtypes
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// BSON element types used by MarshalBSONValue and UnmarshalBSONValue.
+const (
+	bsonTypeString    byte = 0x02
+	bsonTypeUndefined byte = 0x06
+	bsonTypeDateTime  byte = 0x09
+	bsonTypeNull      byte = 0x0A
+)
+
+// bsonDateAsString controls whether MarshalBSONValue encodes dates as strings.
+var bsonDateAsString bool
+
+// SetBSONDateAsString sets whether Date is encoded to BSON as a "yyyy-mm-dd"
+// string instead of a BSON DateTime at midnight UTC (the default).
+// Decoding accepts both forms regardless of this setting.
+// This should be called once at program startup.
+func SetBSONDateAsString(enable bool) {
+	bsonDateAsString = enable
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of the
+// MongoDB Go driver v2. The date is encoded as a BSON DateTime at midnight UTC,
+// or as a "yyyy-mm-dd" string (see SetBSONDateAsString).
+// Zero dates are encoded as null.
+func (date Date) MarshalBSONValue() (byte, []byte, error) {
+	if date.IsZero() {
+		return bsonTypeNull, nil, nil
+	}
+
+	if bsonDateAsString {
+		s := date.String()
+		b := binary.LittleEndian.AppendUint32(nil, uint32(len(s)+1))
+		b = append(b, s...)
+		return bsonTypeString, append(b, 0), nil
+	}
+
+	y, m, d := date.civil()
+	ms := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).UnixMilli()
+	return bsonTypeDateTime, binary.LittleEndian.AppendUint64(nil, uint64(ms)), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of the
+// MongoDB Go driver v2. It decodes a BSON DateTime (truncated to its date in UTC)
+// or a string in the formats accepted by UnmarshalText.
+// null and undefined decode as the zero date.
+func (date *Date) UnmarshalBSONValue(typ byte, data []byte) error {
+	switch typ {
+	case bsonTypeNull, bsonTypeUndefined:
+		*date = Date{}
+		return nil
+	case bsonTypeDateTime:
+		if len(data) != 8 {
+			return fmt.Errorf("dbtypes: invalid BSON DateTime length %d", len(data))
+		}
+		ms := int64(binary.LittleEndian.Uint64(data))
+		y, m, d := time.UnixMilli(ms).UTC().Date()
+		*date = Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+		return nil
+	case bsonTypeString:
+		if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-4 || data[len(data)-1] != 0 {
+			return fmt.Errorf("dbtypes: invalid BSON string")
+		}
+		return date.UnmarshalText(data[4 : len(data)-1])
+	}
+	return fmt.Errorf("dbtypes: cannot decode BSON type 0x%02x into Date", typ)
+}
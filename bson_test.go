@@ -0,0 +1,120 @@
+package dbtypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type bsonPatient struct {
+	Name       string        `bson:"name"`
+	Born       dbtypes.Date  `bson:"born"`
+	Discharged dbtypes.Date  `bson:"discharged,omitempty"`
+	Died       *dbtypes.Date `bson:"died"`
+}
+
+func TestDateBSONRoundTrip(t *testing.T) {
+	defer dbtypes.SetBSONDateAsString(false)
+
+	born := dbtypes.NewDateUTC(1955, time.November, 5)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	died := dbtypes.NewDateIn(2015, time.October, 21, newYork)
+
+	tests := []struct {
+		name     string
+		asString bool
+		patient  bsonPatient
+	}{
+		{name: "datetime", patient: bsonPatient{Name: "doc", Born: born, Died: &died}},
+		{name: "string", asString: true, patient: bsonPatient{Name: "doc", Born: born, Died: &died}},
+		{name: "optional dates missing", patient: bsonPatient{Name: "marty", Born: born}},
+		{name: "zero date", patient: bsonPatient{Name: "unknown"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtypes.SetBSONDateAsString(tt.asString)
+
+			data, err := bson.Marshal(tt.patient)
+			if err != nil {
+				t.Fatalf("bson.Marshal() returned error: %v", err)
+			}
+
+			var raw bson.M
+			if err := bson.Unmarshal(data, &raw); err != nil {
+				t.Fatalf("bson.Unmarshal() returned error: %v", err)
+			}
+			if _, ok := raw["discharged"]; ok {
+				t.Errorf("discharged = %v, want omitted", raw["discharged"])
+			}
+			switch v := raw["born"].(type) {
+			case bson.DateTime:
+				if tt.asString || v.Time().UTC() != time.Time(tt.patient.Born).UTC() {
+					t.Errorf("born = %v, want %s", v.Time().UTC(), tt.patient.Born)
+				}
+			case string:
+				if !tt.asString || v != tt.patient.Born.String() {
+					t.Errorf("born = %q, want %s", v, tt.patient.Born)
+				}
+			case nil:
+				if !tt.patient.Born.IsZero() {
+					t.Errorf("born = null, want %s", tt.patient.Born)
+				}
+			default:
+				t.Errorf("born has type %T", v)
+			}
+
+			var got bsonPatient
+			if err := bson.Unmarshal(data, &got); err != nil {
+				t.Fatalf("bson.Unmarshal() returned error: %v", err)
+			}
+			if got.Born.String() != tt.patient.Born.String() || got.Born.IsZero() != tt.patient.Born.IsZero() {
+				t.Errorf("Born = %s, want %s", got.Born, tt.patient.Born)
+			}
+			if (got.Died == nil) != (tt.patient.Died == nil) ||
+				(got.Died != nil && got.Died.String() != tt.patient.Died.String()) {
+				t.Errorf("Died = %v, want %v", got.Died, tt.patient.Died)
+			}
+		})
+	}
+}
+
+func TestDateBSONDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     bson.D
+		want    string
+		wantErr bool
+	}{
+		{name: "datetime", doc: bson.D{{Key: "born", Value: bson.NewDateTimeFromTime(time.Date(2015, 10, 21, 16, 29, 0, 0, time.UTC))}}, want: "2015-10-21"},
+		{name: "string", doc: bson.D{{Key: "born", Value: "2015-10-21"}}, want: "2015-10-21"},
+		{name: "timestamp string", doc: bson.D{{Key: "born", Value: "2015-10-21T16:29:00Z"}}, want: "2015-10-21"},
+		{name: "empty string", doc: bson.D{{Key: "born", Value: ""}}, want: ""},
+		{name: "null", doc: bson.D{{Key: "born", Value: nil}}, want: ""},
+		{name: "invalid string", doc: bson.D{{Key: "born", Value: "21/10/2015"}}, wantErr: true},
+		{name: "number", doc: bson.D{{Key: "born", Value: int32(20151021)}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(tt.doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := bsonPatient{Born: dbtypes.Today()}
+			err = bson.Unmarshal(data, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("bson.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Born.String() != tt.want {
+				t.Errorf("Born = %s, want %s", got.Born, tt.want)
+			}
+		})
+	}
+}
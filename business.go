@@ -0,0 +1,76 @@
+package dbtypes
+
+// IsBusinessDay reports whether the date is not a weekend day (see SetWeekend).
+func (date Date) IsBusinessDay() bool {
+	return !date.IsWeekend()
+}
+
+// AddBusinessDays returns the date n business days after the date, skipping
+// weekend days as configured with SetWeekend. Negative n moves backwards.
+// If n is 0 the date is returned unchanged, even if it falls on a weekend.
+func (date Date) AddBusinessDays(n int) Date {
+	return addBusinessDays(date, n, Date.IsBusinessDay)
+}
+
+// BusinessDaysBetween returns the number of business days after the earlier
+// of the date and other, up to and including the later one. Weekend days
+// (see SetWeekend) are not counted, so Friday to the following Monday is 1.
+func (date Date) BusinessDaysBetween(other Date) int {
+	return businessDaysBetween(date, other, Date.IsBusinessDay)
+}
+
+// hasBusinessDays reports whether at least one weekday is not part of the weekend.
+func hasBusinessDays() bool {
+	for _, isWeekend := range weekend {
+		if !isWeekend {
+			return true
+		}
+	}
+	return false
+}
+
+// maxNonBusinessDays bounds the consecutive non-business days addBusinessDays
+// skips, so weekends and recurring holidays covering every day can't loop forever.
+const maxNonBusinessDays = 366
+
+func addBusinessDays(date Date, n int, isBusinessDay func(Date) bool) Date {
+	if n == 0 || !hasBusinessDays() {
+		return date
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	// Always step from the original date so DST transitions can't accumulate.
+	offset, skipped := 0, 0
+	for n > 0 {
+		offset += step
+		if isBusinessDay(date.AddDays(offset)) {
+			n--
+			skipped = 0
+			continue
+		}
+		skipped++
+		if skipped > maxNonBusinessDays {
+			return date
+		}
+	}
+	return date.AddDays(offset)
+}
+
+func businessDaysBetween(date, other Date, isBusinessDay func(Date) bool) int {
+	start, end := date, other
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	count := 0
+	for i, days := 1, start.DaysUntil(end); i <= days; i++ {
+		if isBusinessDay(start.AddDays(i)) {
+			count++
+		}
+	}
+	return count
+}
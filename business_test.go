@@ -0,0 +1,94 @@
+package dbtypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateAddBusinessDays(t *testing.T) {
+	friday := dbtypes.NewDate(2023, time.October, 13)
+	saturday := dbtypes.NewDate(2023, time.October, 14)
+	sunday := dbtypes.NewDate(2023, time.October, 15)
+	monday := dbtypes.NewDate(2023, time.October, 16)
+
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		n    int
+		want string
+	}{
+		{name: "zero", date: saturday, n: 0, want: "2023-10-14"},
+		{name: "within week", date: monday, n: 3, want: "2023-10-19"},
+		{name: "over weekend", date: friday, n: 1, want: "2023-10-16"},
+		{name: "start on saturday", date: saturday, n: 1, want: "2023-10-16"},
+		{name: "start on sunday", date: sunday, n: 5, want: "2023-10-20"},
+		{name: "two weeks", date: monday, n: 10, want: "2023-10-30"},
+		{name: "negative over weekend", date: monday, n: -1, want: "2023-10-13"},
+		{name: "negative from sunday", date: sunday, n: -1, want: "2023-10-13"},
+		{name: "negative two weeks", date: friday, n: -10, want: "2023-09-29"},
+		{name: "across year boundary", date: dbtypes.NewDate(2023, time.December, 29), n: 1, want: "2024-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.AddBusinessDays(tt.n); got.String() != tt.want {
+				t.Errorf("AddBusinessDays(%d) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateBusinessDaysBetween(t *testing.T) {
+	tests := []struct {
+		name  string
+		date  dbtypes.Date
+		other dbtypes.Date
+		want  int
+	}{
+		{name: "same day", date: dbtypes.NewDate(2023, time.October, 16), other: dbtypes.NewDate(2023, time.October, 16), want: 0},
+		{name: "monday to friday", date: dbtypes.NewDate(2023, time.October, 16), other: dbtypes.NewDate(2023, time.October, 20), want: 4},
+		{name: "friday to monday", date: dbtypes.NewDate(2023, time.October, 13), other: dbtypes.NewDate(2023, time.October, 16), want: 1},
+		{name: "saturday to sunday", date: dbtypes.NewDate(2023, time.October, 14), other: dbtypes.NewDate(2023, time.October, 15), want: 0},
+		{name: "start on weekend", date: dbtypes.NewDate(2023, time.October, 14), other: dbtypes.NewDate(2023, time.October, 18), want: 3},
+		{name: "end on weekend", date: dbtypes.NewDate(2023, time.October, 18), other: dbtypes.NewDate(2023, time.October, 22), want: 2},
+		{name: "four weeks", date: dbtypes.NewDate(2023, time.October, 2), other: dbtypes.NewDate(2023, time.October, 30), want: 20},
+		{name: "reversed", date: dbtypes.NewDate(2023, time.October, 20), other: dbtypes.NewDate(2023, time.October, 16), want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.BusinessDaysBetween(tt.other); got != tt.want {
+				t.Errorf("BusinessDaysBetween() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysCustomWeekend(t *testing.T) {
+	dbtypes.SetWeekend(time.Friday, time.Saturday)
+	defer dbtypes.SetWeekend()
+
+	thursday := dbtypes.NewDate(2023, time.October, 12)
+	if got := thursday.AddBusinessDays(1); got.String() != "2023-10-15" {
+		t.Errorf("AddBusinessDays(1) = %s, want 2023-10-15", got)
+	}
+	if got := thursday.BusinessDaysBetween(dbtypes.NewDate(2023, time.October, 16)); got != 2 {
+		t.Errorf("BusinessDaysBetween() = %d, want 2", got)
+	}
+}
+
+func TestAddBusinessDaysInverse(t *testing.T) {
+	start := dbtypes.NewDate(2023, time.October, 2)
+	for n := -15; n <= 15; n++ {
+		end := start.AddBusinessDays(n)
+		want := n
+		if want < 0 {
+			want = -want
+		}
+		if got := start.BusinessDaysBetween(end); got != want {
+			t.Errorf("BusinessDaysBetween(AddBusinessDays(%d)) = %d, want %d", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,421 @@
+package dbtypes
+
+import (
+	"fmt"
+	"time"
+)
+
+// isoWeekLayout describes the format accepted by ParseWeek and ParseISOWeek.
+const isoWeekLayout = "yyyy-Www"
+
+// ISOWeek returns the ISO 8601 year and week number in which the date occurs.
+// Week ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to
+// week 52 or 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1 of year n+1.
+func (date Date) ISOWeek() (year, week int) {
+	return time.Time(date).ISOWeek()
+}
+
+// ISOWeekString returns the ISO 8601 week of the date formatted like "2023-W42".
+func (date Date) ISOWeekString() string {
+	year, week := date.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// ParseISOWeek parses an ISO 8601 week like "2023-W42" and returns
+// the Monday of that week in the default location (see SetDefaultLocation).
+func ParseISOWeek(s string) (Date, error) {
+	week, err := ParseWeek(s)
+	if err != nil {
+		return Date{}, err
+	}
+	return week.Start(), nil
+}
+
+// isoWeekStart returns the Monday of the given ISO week.
+// Jan 4 is always in week 1.
+func isoWeekStart(year, week int) Date {
+	jan4 := NewDate(year, time.January, 4)
+	offset := (int(jan4.Weekday()) + 6) % 7 // days since Monday
+	return jan4.AddDays(-offset + (week-1)*7)
+}
+
+// isoWeeksInYear returns the number of ISO weeks (52 or 53) in the ISO year.
+// Dec 28 is always in the last week of its ISO year.
+func isoWeeksInYear(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// StartOfMonth returns the first day of the date's month in the date's location.
+// The zero date is returned unchanged.
+func (date Date) StartOfMonth() Date {
+	if date.IsZero() {
+		return date
+	}
+
+	t := time.Time(date)
+	return NewDateIn(t.Year(), t.Month(), 1, t.Location())
+}
+
+// EndOfMonth returns the last day of the date's month in the date's location.
+// The zero date is returned unchanged.
+func (date Date) EndOfMonth() Date {
+	if date.IsZero() {
+		return date
+	}
+
+	t := time.Time(date)
+	return NewDateIn(t.Year(), t.Month(), date.DaysInMonth(), t.Location())
+}
+
+// firstDayOfWeek is the first day of the week used by WeekStart and WeekEnd.
+var firstDayOfWeek = time.Monday
+
+// SetFirstDayOfWeek sets the first day of the week used by WeekStart and WeekEnd.
+// The default is Monday as in ISO 8601.
+// This should be called once at program startup.
+func SetFirstDayOfWeek(day time.Weekday) {
+	firstDayOfWeek = day % 7
+}
+
+// StartOfWeek returns the first day of the date's week, where weeks start on firstDay.
+// The zero date is returned unchanged.
+func (date Date) StartOfWeek(firstDay time.Weekday) Date {
+	if date.IsZero() {
+		return date
+	}
+
+	offset := (int(date.Weekday()) - int(firstDay%7) + 7) % 7
+	return date.AddDays(-offset)
+}
+
+// EndOfWeek returns the last day of the date's week, where weeks start on firstDay.
+// The zero date is returned unchanged.
+func (date Date) EndOfWeek(firstDay time.Weekday) Date {
+	if date.IsZero() {
+		return date
+	}
+	return date.StartOfWeek(firstDay).AddDays(6)
+}
+
+// WeekStart returns the first day of the date's week using the
+// package default first day of the week (see SetFirstDayOfWeek).
+func (date Date) WeekStart() Date {
+	return date.StartOfWeek(firstDayOfWeek)
+}
+
+// WeekEnd returns the last day of the date's week using the
+// package default first day of the week (see SetFirstDayOfWeek).
+func (date Date) WeekEnd() Date {
+	return date.EndOfWeek(firstDayOfWeek)
+}
+
+// StartOfYear returns January 1 of the date's year in the date's location.
+// The zero date is returned unchanged.
+func (date Date) StartOfYear() Date {
+	if date.IsZero() {
+		return date
+	}
+
+	t := time.Time(date)
+	return NewDateIn(t.Year(), time.January, 1, t.Location())
+}
+
+// EndOfYear returns December 31 of the date's year in the date's location.
+// The zero date is returned unchanged.
+func (date Date) EndOfYear() Date {
+	if date.IsZero() {
+		return date
+	}
+
+	t := time.Time(date)
+	return NewDateIn(t.Year(), time.December, 31, t.Location())
+}
+
+// YearRange returns January 1 and December 31 of year in the default location,
+// suitable as inclusive bounds of a BETWEEN query.
+func YearRange(year int) (Date, Date) {
+	return NewDate(year, time.January, 1), NewDate(year, time.December, 31)
+}
+
+// Quarter returns the quarter (1-4) of the year in which the date occurs.
+func (date Date) Quarter() int {
+	return (date.Month()-1)/3 + 1
+}
+
+// StartOfQuarter returns the first day of the date's quarter in the date's location.
+// The zero date is returned unchanged.
+func (date Date) StartOfQuarter() Date {
+	if date.IsZero() {
+		return date
+	}
+
+	t := time.Time(date)
+	return NewDateIn(t.Year(), quarterStartMonth(date.Quarter()), 1, t.Location())
+}
+
+// EndOfQuarter returns the last day of the date's quarter in the date's location.
+// The zero date is returned unchanged.
+func (date Date) EndOfQuarter() Date {
+	if date.IsZero() {
+		return date
+	}
+
+	t := time.Time(date)
+	// Day 0 of the month after the quarter is the quarter's last day.
+	return NewDateIn(t.Year(), quarterStartMonth(date.Quarter())+3, 0, t.Location())
+}
+
+// NewQuarterDate returns the first day of quarter of year in the default location.
+// Quarters outside 1-4 are normalized like time.Date does for months,
+// e.g. quarter 5 of 2023 is quarter 1 of 2024.
+func NewQuarterDate(year, quarter int) Date {
+	return NewDate(year, quarterStartMonth(quarter), 1)
+}
+
+func quarterStartMonth(quarter int) time.Month {
+	return time.Month((quarter-1)*3 + 1)
+}
+
+// DayOfYear returns the day of the year (1-365, or 1-366 in leap years) of the date.
+func (date Date) DayOfYear() int {
+	return time.Time(date).YearDay()
+}
+
+// DateFromYearDay returns the date of the given day (1-based) of year in the
+// default location. It returns an error wrapping ErrDateOutOfRange if day is
+// not within the year.
+func DateFromYearDay(year, day int) (Date, error) {
+	if day < 1 || day > daysInYear(year) {
+		return Date{}, fmt.Errorf("day %d of year %d: %w", day, year, ErrDateOutOfRange)
+	}
+	return NewDate(year, time.January, day), nil
+}
+
+// Age returns the number of completed years between the date (e.g. a birth date)
+// and asOf. It returns 0 if asOf is before the date.
+//
+// For Feb 29 birthdays the birthday is counted on Mar 1 in non-leap years,
+// so the age increments on Mar 1 rather than Feb 28.
+func (date Date) Age(asOf Date) int {
+	if asOf.Before(date) {
+		return 0
+	}
+
+	age := asOf.Year() - date.Year()
+	if asOf.Month() < date.Month() || (asOf.Month() == date.Month() && asOf.Day() < date.Day()) {
+		age--
+	}
+	return age
+}
+
+// AgeToday returns the age of the date as of Today().
+func (date Date) AgeToday() int {
+	return date.Age(Today())
+}
+
+// LeapDayRule selects the day on which a Feb 29 anniversary falls in non-leap years.
+type LeapDayRule int
+
+const (
+	LeapDayFeb28 LeapDayRule = iota // Feb 29 anniversaries fall on Feb 28 (default)
+	LeapDayMar1                     // Feb 29 anniversaries fall on Mar 1
+)
+
+// NextAnniversary returns the first anniversary of the date's month and day
+// on or after after, so an anniversary falling on after itself is returned.
+// It returns the zero Date if either date is zero. Feb 29 anniversaries fall
+// on Feb 28 in non-leap years unless LeapDayMar1 is passed as rule.
+func (date Date) NextAnniversary(after Date, rule ...LeapDayRule) Date {
+	if date.IsZero() || after.IsZero() {
+		return Date{}
+	}
+
+	anniversary := date.anniversaryIn(after.Year(), rule)
+	if anniversary.Before(after) {
+		anniversary = date.anniversaryIn(after.Year()+1, rule)
+	}
+	return anniversary
+}
+
+// PreviousAnniversary returns the last anniversary of the date's month and day
+// on or before before, so an anniversary falling on before itself is returned.
+// It returns the zero Date if either date is zero. See NextAnniversary for
+// Feb 29 dates.
+func (date Date) PreviousAnniversary(before Date, rule ...LeapDayRule) Date {
+	if date.IsZero() || before.IsZero() {
+		return Date{}
+	}
+
+	anniversary := date.anniversaryIn(before.Year(), rule)
+	if anniversary.After(before) {
+		anniversary = date.anniversaryIn(before.Year()-1, rule)
+	}
+	return anniversary
+}
+
+// anniversaryIn returns the anniversary of the date in year, applying the
+// last of rule (LeapDayFeb28 if empty) in the date's location.
+func (date Date) anniversaryIn(year int, rule []LeapDayRule) Date {
+	_, month, day := date.civil()
+	if month == time.February && day == 29 && !IsLeapYear(year) {
+		if len(rule) > 0 && rule[len(rule)-1] == LeapDayMar1 {
+			month, day = time.March, 1
+		} else {
+			day = 28
+		}
+	}
+	return NewDateIn(year, month, day, time.Time(date).Location())
+}
+
+// civil returns the date's year, month and day in its own location.
+func (date Date) civil() (int, time.Month, int) {
+	return time.Time(date).Date()
+}
+
+// addMonthsClamped adds months to the civil date, clamping the day to the
+// last day of the resulting month instead of overflowing into the next one,
+// e.g. Jan 31 + 1 month is Feb 28 (or 29).
+func addMonthsClamped(year int, month time.Month, day, months int) (int, time.Month, int) {
+	total := year*12 + int(month-1) + months
+	y, m := total/12, time.Month(total%12+1)
+	if total%12 < 0 {
+		y, m = (total-11)/12, time.Month(total%12+13)
+	}
+
+	if days := daysInMonth(y, m); day > days {
+		day = days
+	}
+	return y, m, day
+}
+
+// civilDaysOf returns the number of days since 1970-01-01 of the civil date.
+func civilDaysOf(year int, month time.Month, day int) int64 {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// minMaxSkipZero controls whether MinDate, MaxDate, Date.Min and Date.Max ignore zero dates.
+var minMaxSkipZero = true
+
+// SetMinMaxSkipZero sets whether MinDate, MaxDate, Date.Min and Date.Max ignore
+// zero dates, which usually represent missing values. It is enabled by default.
+// This should be called once at program startup.
+func SetMinMaxSkipZero(skip bool) {
+	minMaxSkipZero = skip
+}
+
+// MinDate returns the earliest of dates by calendar day.
+// Zero dates are skipped unless disabled with SetMinMaxSkipZero(false).
+// It returns the zero Date if dates is empty or contains only skipped dates.
+// If several dates fall on the earliest day, the first one is returned.
+func MinDate(dates ...Date) Date {
+	return extremeDate(dates, -1)
+}
+
+// MaxDate returns the latest of dates by calendar day.
+// Zero dates are skipped unless disabled with SetMinMaxSkipZero(false).
+// It returns the zero Date if dates is empty or contains only skipped dates.
+// If several dates fall on the latest day, the first one is returned.
+func MaxDate(dates ...Date) Date {
+	return extremeDate(dates, 1)
+}
+
+// extremeDate returns the minimum (want -1) or maximum (want 1) of dates.
+func extremeDate(dates []Date, want int) Date {
+	var result Date
+	found := false
+	for _, date := range dates {
+		if minMaxSkipZero && date.IsZero() {
+			continue
+		}
+		if !found || date.Compare(result) == want {
+			result, found = date, true
+		}
+	}
+	return result
+}
+
+// Min returns the earlier of the date and other, as MinDate(date, other).
+func (date Date) Min(other Date) Date {
+	return MinDate(date, other)
+}
+
+// Max returns the later of the date and other, as MaxDate(date, other).
+func (date Date) Max(other Date) Date {
+	return MaxDate(date, other)
+}
+
+// Clamp returns min if the date is before min, max if it is after max,
+// and the date itself otherwise, comparing calendar days.
+// A zero min or max means the range is unbounded on that side.
+// Clamp panics if both bounds are set and min is after max.
+func (date Date) Clamp(min, max Date) Date {
+	if !min.IsZero() && !max.IsZero() && min.After(max) {
+		panic(fmt.Sprintf("dbtypes: Clamp called with min %s after max %s", min, max))
+	}
+
+	if !min.IsZero() && date.Before(min) {
+		return min
+	}
+	if !max.IsZero() && date.After(max) {
+		return max
+	}
+	return date
+}
+
+// NextWeekday returns the first date strictly after the date that falls on w.
+func (date Date) NextWeekday(w time.Weekday) Date {
+	return date.AddDays(1).OnOrAfter(w)
+}
+
+// PrevWeekday returns the last date strictly before the date that falls on w.
+func (date Date) PrevWeekday(w time.Weekday) Date {
+	return date.AddDays(-1).OnOrBefore(w)
+}
+
+// OnOrAfter returns the first date on or after the date that falls on w.
+// The date itself is returned if it already falls on w.
+func (date Date) OnOrAfter(w time.Weekday) Date {
+	return date.AddDays((int(w%7) - int(date.Weekday()) + 7) % 7)
+}
+
+// OnOrBefore returns the last date on or before the date that falls on w.
+// The date itself is returned if it already falls on w.
+func (date Date) OnOrBefore(w time.Weekday) Date {
+	return date.AddDays(-((int(date.Weekday()) - int(w%7) + 7) % 7))
+}
+
+// MonthGrid returns the weeks of a calendar month as rows of seven dates
+// starting on firstDay, as shown by a date picker. The first and last rows
+// are padded with days from the adjacent months, giving 4 to 6 rows.
+// Dates are in the default location (see SetDefaultLocation).
+func MonthGrid(year int, month time.Month, firstDay time.Weekday) [][7]Date {
+	return monthGrid(year, month, firstDay, false)
+}
+
+// MonthGridZeroPadded is like MonthGrid but pads the first and last rows
+// with zero Dates instead of days from the adjacent months.
+func MonthGridZeroPadded(year int, month time.Month, firstDay time.Weekday) [][7]Date {
+	return monthGrid(year, month, firstDay, true)
+}
+
+func monthGrid(year int, month time.Month, firstDay time.Weekday, zeroPad bool) [][7]Date {
+	first := NewDate(year, month, 1)
+	year, month, _ = first.civil() // normalize out of range months
+	gridStart := first.StartOfWeek(firstDay)
+
+	leading := first.civilDays() - gridStart.civilDays()
+	rows := (int(leading) + daysInMonth(year, month) + 6) / 7
+
+	grid := make([][7]Date, rows)
+	for i := range grid {
+		for j := range grid[i] {
+			date := gridStart.AddDays(i*7 + j)
+			if y, m, _ := date.civil(); zeroPad && (y != year || m != month) {
+				continue
+			}
+			grid[i][j] = date
+		}
+	}
+	return grid
+}
@@ -0,0 +1,733 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateISOWeek(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     dbtypes.Date
+		wantYear int
+		wantWeek int
+		want     string
+	}{
+		{name: "mid year", date: dbtypes.NewDate(2023, time.October, 18), wantYear: 2023, wantWeek: 42, want: "2023-W42"},
+		{name: "Jan 1 in week 52 of previous year", date: dbtypes.NewDate(2023, time.January, 1), wantYear: 2022, wantWeek: 52, want: "2022-W52"},
+		{name: "Jan 1 in week 53 of previous year", date: dbtypes.NewDate(2021, time.January, 1), wantYear: 2020, wantWeek: 53, want: "2020-W53"},
+		{name: "Dec 31 in week 1 of next year", date: dbtypes.NewDate(2024, time.December, 31), wantYear: 2025, wantWeek: 1, want: "2025-W01"},
+		{name: "Dec 29 in week 1 of next year", date: dbtypes.NewDate(2014, time.December, 29), wantYear: 2015, wantWeek: 1, want: "2015-W01"},
+		{name: "Jan 4 always in week 1", date: dbtypes.NewDate(2021, time.January, 4), wantYear: 2021, wantWeek: 1, want: "2021-W01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			year, week := tt.date.ISOWeek()
+			if year != tt.wantYear || week != tt.wantWeek {
+				t.Errorf("ISOWeek() = %d, %d; want %d, %d", year, week, tt.wantYear, tt.wantWeek)
+			}
+			if got := tt.date.ISOWeekString(); got != tt.want {
+				t.Errorf("ISOWeekString() = %s, want %s", got, tt.want)
+			}
+
+			monday, err := dbtypes.ParseISOWeek(tt.want)
+			if err != nil {
+				t.Fatalf("ParseISOWeek(%s) returned error: %v", tt.want, err)
+			}
+			if monday.Weekday() != time.Monday {
+				t.Errorf("ParseISOWeek(%s) = %s is a %v", tt.want, monday, monday.Weekday())
+			}
+			if days := monday.DaysUntil(tt.date); days < 0 || days > 6 {
+				t.Errorf("ParseISOWeek(%s) = %s, not the Monday of %s", tt.want, monday, tt.date)
+			}
+		})
+	}
+}
+
+func TestParseISOWeek(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr error
+	}{
+		{input: "2023-W42", want: "2023-10-16"},
+		{input: "2020-W53", want: "2020-12-28"},
+		{input: "2025-W01", want: "2024-12-30"},
+		{input: "2023-W01", want: "2023-01-02"},
+		{input: "2023-W53", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-W00", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-W5", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023W42", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023-Wxx", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "", wantErr: dbtypes.ErrEmptyDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := dbtypes.ParseISOWeek(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseISOWeek(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseISOWeek(%q) returned error: %v", tt.input, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseISOWeek(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateStartEndOfMonth(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*3600)
+
+	tests := []struct {
+		name      string
+		date      dbtypes.Date
+		wantStart string
+		wantEnd   string
+	}{
+		{name: "31 days", date: dbtypes.NewDateIn(2023, time.January, 31, eat), wantStart: "2023-01-01", wantEnd: "2023-01-31"},
+		{name: "30 days", date: dbtypes.NewDateIn(2023, time.April, 15, eat), wantStart: "2023-04-01", wantEnd: "2023-04-30"},
+		{name: "29 days", date: dbtypes.NewDateIn(2024, time.February, 1, eat), wantStart: "2024-02-01", wantEnd: "2024-02-29"},
+		{name: "28 days", date: dbtypes.NewDateIn(2023, time.February, 28, eat), wantStart: "2023-02-01", wantEnd: "2023-02-28"},
+		{name: "December", date: dbtypes.NewDateIn(2023, time.December, 25, eat), wantStart: "2023-12-01", wantEnd: "2023-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.date
+			start, end := tt.date.StartOfMonth(), tt.date.EndOfMonth()
+
+			if start.String() != tt.wantStart {
+				t.Errorf("StartOfMonth() = %s, want %s", start, tt.wantStart)
+			}
+			if end.String() != tt.wantEnd {
+				t.Errorf("EndOfMonth() = %s, want %s", end, tt.wantEnd)
+			}
+			if time.Time(start).Location() != eat || time.Time(end).Location() != eat {
+				t.Errorf("StartOfMonth()/EndOfMonth() did not preserve the location")
+			}
+			if !time.Time(tt.date).Equal(time.Time(original)) {
+				t.Errorf("StartOfMonth()/EndOfMonth() mutated the receiver")
+			}
+		})
+	}
+
+	var zero dbtypes.Date
+	if !zero.StartOfMonth().IsZero() || !zero.EndOfMonth().IsZero() {
+		t.Errorf("StartOfMonth()/EndOfMonth() of zero date should stay zero")
+	}
+}
+
+func TestDateDaysInMonthEndOfLongMonth(t *testing.T) {
+	// Jan 31 + 1 month overflows into March, which must not affect the result.
+	if got := dbtypes.NewDate(2023, time.January, 31).DaysInMonth(); got != 31 {
+		t.Errorf("DaysInMonth() = %d, want 31", got)
+	}
+	if got := dbtypes.NewDate(2023, time.October, 31).DaysInMonth(); got != 31 {
+		t.Errorf("DaysInMonth() = %d, want 31", got)
+	}
+}
+
+func TestDateStartEndOfWeek(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      dbtypes.Date
+		firstDay  time.Weekday
+		wantStart string
+		wantEnd   string
+	}{
+		{name: "Monday week, mid week", date: dbtypes.NewDate(2023, time.October, 18), firstDay: time.Monday, wantStart: "2023-10-16", wantEnd: "2023-10-22"},
+		{name: "Sunday week, mid week", date: dbtypes.NewDate(2023, time.October, 18), firstDay: time.Sunday, wantStart: "2023-10-15", wantEnd: "2023-10-21"},
+		{name: "date is first day", date: dbtypes.NewDate(2023, time.October, 16), firstDay: time.Monday, wantStart: "2023-10-16", wantEnd: "2023-10-22"},
+		{name: "date is last day", date: dbtypes.NewDate(2023, time.October, 22), firstDay: time.Monday, wantStart: "2023-10-16", wantEnd: "2023-10-22"},
+		{name: "Sunday week, date is last day", date: dbtypes.NewDate(2023, time.October, 21), firstDay: time.Sunday, wantStart: "2023-10-15", wantEnd: "2023-10-21"},
+		{name: "start in previous year", date: dbtypes.NewDate(2023, time.January, 1), firstDay: time.Monday, wantStart: "2022-12-26", wantEnd: "2023-01-01"},
+		{name: "end in next year", date: dbtypes.NewDate(2024, time.December, 31), firstDay: time.Sunday, wantStart: "2024-12-29", wantEnd: "2025-01-04"},
+		{name: "Saturday week", date: dbtypes.NewDate(2023, time.October, 20), firstDay: time.Saturday, wantStart: "2023-10-14", wantEnd: "2023-10-20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.StartOfWeek(tt.firstDay); got.String() != tt.wantStart {
+				t.Errorf("StartOfWeek(%v) = %s, want %s", tt.firstDay, got, tt.wantStart)
+			}
+			if got := tt.date.EndOfWeek(tt.firstDay); got.String() != tt.wantEnd {
+				t.Errorf("EndOfWeek(%v) = %s, want %s", tt.firstDay, got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestDateWeekStartDefault(t *testing.T) {
+	defer dbtypes.SetFirstDayOfWeek(time.Monday)
+
+	date := dbtypes.NewDate(2023, time.October, 18)
+	if got := date.WeekStart(); got.String() != "2023-10-16" {
+		t.Errorf("WeekStart() = %s, want 2023-10-16", got)
+	}
+
+	dbtypes.SetFirstDayOfWeek(time.Sunday)
+	if got := date.WeekStart(); got.String() != "2023-10-15" {
+		t.Errorf("WeekStart() = %s, want 2023-10-15", got)
+	}
+	if got := date.WeekEnd(); got.String() != "2023-10-21" {
+		t.Errorf("WeekEnd() = %s, want 2023-10-21", got)
+	}
+}
+
+func TestDateStartEndOfYear(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*3600)
+
+	tests := []struct {
+		name      string
+		date      dbtypes.Date
+		wantStart string
+		wantEnd   string
+	}{
+		{name: "leap year", date: dbtypes.NewDateIn(2024, time.February, 29, eat), wantStart: "2024-01-01", wantEnd: "2024-12-31"},
+		{name: "non-leap year", date: dbtypes.NewDateIn(2023, time.July, 4, eat), wantStart: "2023-01-01", wantEnd: "2023-12-31"},
+		{name: "first day", date: dbtypes.NewDateIn(2023, time.January, 1, eat), wantStart: "2023-01-01", wantEnd: "2023-12-31"},
+		{name: "last day", date: dbtypes.NewDateIn(2023, time.December, 31, eat), wantStart: "2023-01-01", wantEnd: "2023-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.date.StartOfYear(), tt.date.EndOfYear()
+			if start.String() != tt.wantStart {
+				t.Errorf("StartOfYear() = %s, want %s", start, tt.wantStart)
+			}
+			if end.String() != tt.wantEnd {
+				t.Errorf("EndOfYear() = %s, want %s", end, tt.wantEnd)
+			}
+			if time.Time(start).Location() != eat || time.Time(end).Location() != eat {
+				t.Errorf("StartOfYear()/EndOfYear() did not preserve the location")
+			}
+		})
+	}
+
+	var zero dbtypes.Date
+	if !zero.StartOfYear().IsZero() || !zero.EndOfYear().IsZero() {
+		t.Errorf("StartOfYear()/EndOfYear() of zero date should stay zero")
+	}
+}
+
+func TestYearRange(t *testing.T) {
+	start, end := dbtypes.YearRange(2024)
+	if start.String() != "2024-01-01" || end.String() != "2024-12-31" {
+		t.Errorf("YearRange(2024) = %s, %s", start, end)
+	}
+	if days := start.DaysUntil(end) + 1; days != 366 {
+		t.Errorf("YearRange(2024) spans %d days, want 366", days)
+	}
+}
+
+func TestDateQuarter(t *testing.T) {
+	want := map[time.Month]int{
+		time.January: 1, time.February: 1, time.March: 1,
+		time.April: 2, time.May: 2, time.June: 2,
+		time.July: 3, time.August: 3, time.September: 3,
+		time.October: 4, time.November: 4, time.December: 4,
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		date := dbtypes.NewDate(2023, month, 15)
+		if got := date.Quarter(); got != want[month] {
+			t.Errorf("%s.Quarter() = %d, want %d", date, got, want[month])
+		}
+	}
+}
+
+func TestDateStartEndOfQuarter(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      dbtypes.Date
+		wantStart string
+		wantEnd   string
+	}{
+		{name: "Q1 leap year", date: dbtypes.NewDate(2024, time.February, 29), wantStart: "2024-01-01", wantEnd: "2024-03-31"},
+		{name: "Q1 non-leap year", date: dbtypes.NewDate(2023, time.January, 31), wantStart: "2023-01-01", wantEnd: "2023-03-31"},
+		{name: "Q2 first day", date: dbtypes.NewDate(2023, time.April, 1), wantStart: "2023-04-01", wantEnd: "2023-06-30"},
+		{name: "Q3 last day", date: dbtypes.NewDate(2023, time.September, 30), wantStart: "2023-07-01", wantEnd: "2023-09-30"},
+		{name: "Q4", date: dbtypes.NewDate(2023, time.November, 30), wantStart: "2023-10-01", wantEnd: "2023-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.date.StartOfQuarter(), tt.date.EndOfQuarter()
+			if start.String() != tt.wantStart {
+				t.Errorf("StartOfQuarter() = %s, want %s", start, tt.wantStart)
+			}
+			if end.String() != tt.wantEnd {
+				t.Errorf("EndOfQuarter() = %s, want %s", end, tt.wantEnd)
+			}
+
+			// Quarters compose with AddMonths.
+			next := start.AddMonths(3)
+			if next.Quarter() != tt.date.AddMonths(3).StartOfQuarter().Quarter() || !end.AddDays(1).Equal(next) {
+				t.Errorf("StartOfQuarter().AddMonths(3) = %s, want the day after %s", next, end)
+			}
+		})
+	}
+}
+
+func TestNewQuarterDate(t *testing.T) {
+	tests := []struct {
+		year    int
+		quarter int
+		want    string
+	}{
+		{year: 2023, quarter: 1, want: "2023-01-01"},
+		{year: 2023, quarter: 2, want: "2023-04-01"},
+		{year: 2023, quarter: 3, want: "2023-07-01"},
+		{year: 2023, quarter: 4, want: "2023-10-01"},
+		{year: 2023, quarter: 5, want: "2024-01-01"},
+		{year: 2023, quarter: 0, want: "2022-10-01"},
+	}
+
+	for _, tt := range tests {
+		if got := dbtypes.NewQuarterDate(tt.year, tt.quarter); got.String() != tt.want {
+			t.Errorf("NewQuarterDate(%d, %d) = %s, want %s", tt.year, tt.quarter, got, tt.want)
+		}
+	}
+}
+
+func TestDateDayOfYear(t *testing.T) {
+	tests := []struct {
+		date dbtypes.Date
+		want int
+	}{
+		{date: dbtypes.NewDate(2023, time.January, 1), want: 1},
+		{date: dbtypes.NewDate(2024, time.February, 29), want: 60},
+		{date: dbtypes.NewDate(2023, time.March, 1), want: 60},
+		{date: dbtypes.NewDate(2024, time.March, 1), want: 61},
+		{date: dbtypes.NewDate(2023, time.December, 31), want: 365},
+		{date: dbtypes.NewDate(2024, time.December, 31), want: 366},
+	}
+
+	for _, tt := range tests {
+		got := tt.date.DayOfYear()
+		if got != tt.want {
+			t.Errorf("%s.DayOfYear() = %d, want %d", tt.date, got, tt.want)
+		}
+
+		date, err := dbtypes.DateFromYearDay(tt.date.Year(), got)
+		if err != nil {
+			t.Fatalf("DateFromYearDay(%d, %d) returned error: %v", tt.date.Year(), got, err)
+		}
+		if !date.Equal(tt.date) {
+			t.Errorf("DateFromYearDay(%d, %d) = %s, want %s", tt.date.Year(), got, date, tt.date)
+		}
+	}
+}
+
+func TestDateFromYearDayOutOfRange(t *testing.T) {
+	tests := []struct {
+		year int
+		day  int
+	}{
+		{year: 2021, day: 366},
+		{year: 2024, day: 367},
+		{year: 2024, day: 0},
+		{year: 2024, day: -1},
+	}
+
+	for _, tt := range tests {
+		if _, err := dbtypes.DateFromYearDay(tt.year, tt.day); !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+			t.Errorf("DateFromYearDay(%d, %d) error = %v, want ErrDateOutOfRange", tt.year, tt.day, err)
+		}
+	}
+}
+
+func TestIsLeapYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want bool
+	}{
+		{year: 1900, want: false},
+		{year: 2000, want: true},
+		{year: 2100, want: false},
+		{year: 2400, want: true},
+		{year: 2023, want: false},
+		{year: 2024, want: true},
+		{year: 0, want: true},
+		{year: -4, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := dbtypes.IsLeapYear(tt.year); got != tt.want {
+			t.Errorf("IsLeapYear(%d) = %v, want %v", tt.year, got, tt.want)
+		}
+
+		date := dbtypes.NewDateUTC(tt.year, time.March, 1)
+		if got := date.IsLeapYear(); got != tt.want {
+			t.Errorf("%s.IsLeapYear() = %v, want %v", date, got, tt.want)
+		}
+
+		wantDays := 365
+		if tt.want {
+			wantDays = 366
+		}
+		if got := date.DaysInYear(); got != wantDays {
+			t.Errorf("%s.DaysInYear() = %d, want %d", date, got, wantDays)
+		}
+
+		wantFeb := 28
+		if tt.want {
+			wantFeb = 29
+		}
+		if got := dbtypes.NewDateUTC(tt.year, time.February, 1).DaysInMonth(); got != wantFeb {
+			t.Errorf("DaysInMonth() of February %d = %d, want %d", tt.year, got, wantFeb)
+		}
+	}
+}
+
+func TestDateAge(t *testing.T) {
+	birth := dbtypes.NewDate(1990, time.June, 15)
+	leapling := dbtypes.NewDate(2000, time.February, 29)
+
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		asOf dbtypes.Date
+		want int
+	}{
+		{name: "day before birthday", date: birth, asOf: dbtypes.NewDate(2023, time.June, 14), want: 32},
+		{name: "on birthday", date: birth, asOf: dbtypes.NewDate(2023, time.June, 15), want: 33},
+		{name: "birthday later in year", date: birth, asOf: dbtypes.NewDate(2023, time.January, 1), want: 32},
+		{name: "birthday earlier in year", date: birth, asOf: dbtypes.NewDate(2023, time.December, 31), want: 33},
+		{name: "same day as birth", date: birth, asOf: birth, want: 0},
+		{name: "asOf before birth", date: birth, asOf: dbtypes.NewDate(1980, time.January, 1), want: 0},
+		{name: "Feb 29 birthday on Feb 28 of non-leap year", date: leapling, asOf: dbtypes.NewDate(2023, time.February, 28), want: 22},
+		{name: "Feb 29 birthday on Mar 1 of non-leap year", date: leapling, asOf: dbtypes.NewDate(2023, time.March, 1), want: 23},
+		{name: "Feb 29 birthday on Feb 29 of leap year", date: leapling, asOf: dbtypes.NewDate(2024, time.February, 29), want: 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Age(tt.asOf); got != tt.want {
+				t.Errorf("Age(%s) = %d, want %d", tt.asOf, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := birth.AgeToday(), birth.Age(dbtypes.Today()); got != want {
+		t.Errorf("AgeToday() = %d, want %d", got, want)
+	}
+}
+
+func TestMinMaxDate(t *testing.T) {
+	jan := dbtypes.NewDate(2023, time.January, 1)
+	feb := dbtypes.NewDate(2023, time.February, 1)
+	mar := dbtypes.NewDate(2023, time.March, 1)
+	zero := dbtypes.Date{}
+
+	tests := []struct {
+		name    string
+		dates   []dbtypes.Date
+		wantMin dbtypes.Date
+		wantMax dbtypes.Date
+	}{
+		{name: "empty", dates: nil, wantMin: zero, wantMax: zero},
+		{name: "all zero", dates: []dbtypes.Date{zero, zero}, wantMin: zero, wantMax: zero},
+		{name: "single", dates: []dbtypes.Date{feb}, wantMin: feb, wantMax: feb},
+		{name: "unordered", dates: []dbtypes.Date{feb, mar, jan}, wantMin: jan, wantMax: mar},
+		{name: "zero skipped", dates: []dbtypes.Date{zero, feb, zero, mar}, wantMin: feb, wantMax: mar},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dbtypes.MinDate(tt.dates...); !got.Equal(tt.wantMin) {
+				t.Errorf("MinDate() = %s, want %s", got, tt.wantMin)
+			}
+			if got := dbtypes.MaxDate(tt.dates...); !got.Equal(tt.wantMax) {
+				t.Errorf("MaxDate() = %s, want %s", got, tt.wantMax)
+			}
+		})
+	}
+
+	if got := jan.Min(mar); !got.Equal(jan) {
+		t.Errorf("Min() = %s, want %s", got, jan)
+	}
+	if got := jan.Max(mar); !got.Equal(mar) {
+		t.Errorf("Max() = %s, want %s", got, mar)
+	}
+	if got := zero.Min(mar); !got.Equal(mar) {
+		t.Errorf("zero.Min() = %s, want %s", got, mar)
+	}
+}
+
+func TestMinMaxDateIncludingZero(t *testing.T) {
+	dbtypes.SetMinMaxSkipZero(false)
+	defer dbtypes.SetMinMaxSkipZero(true)
+
+	feb := dbtypes.NewDate(2023, time.February, 1)
+	zero := dbtypes.Date{}
+
+	if got := dbtypes.MinDate(feb, zero); !got.IsZero() {
+		t.Errorf("MinDate() = %s, want zero date", got)
+	}
+	if got := dbtypes.MaxDate(zero, feb); !got.Equal(feb) {
+		t.Errorf("MaxDate() = %s, want %s", got, feb)
+	}
+	if got := feb.Min(zero); !got.IsZero() {
+		t.Errorf("Min() = %s, want zero date", got)
+	}
+}
+
+func TestMinDateTiesReturnFirst(t *testing.T) {
+	utc := dbtypes.NewDateUTC(2023, time.February, 1)
+	eat := dbtypes.NewDateIn(2023, time.February, 1, time.FixedZone("EAT", 3*3600))
+
+	if got := dbtypes.MinDate(eat, utc); time.Time(got).Location() != time.Time(eat).Location() {
+		t.Errorf("MinDate() returned %v, want the first of equal dates", time.Time(got))
+	}
+	if got := dbtypes.MaxDate(utc, eat); time.Time(got).Location() != time.UTC {
+		t.Errorf("MaxDate() returned %v, want the first of equal dates", time.Time(got))
+	}
+}
+
+func TestDateClamp(t *testing.T) {
+	min := dbtypes.NewDate(2023, time.January, 1)
+	max := dbtypes.NewDate(2023, time.December, 31)
+	var zero dbtypes.Date
+
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		min  dbtypes.Date
+		max  dbtypes.Date
+		want string
+	}{
+		{name: "within range", date: dbtypes.NewDate(2023, time.June, 1), min: min, max: max, want: "2023-06-01"},
+		{name: "before min", date: dbtypes.NewDate(2022, time.June, 1), min: min, max: max, want: "2023-01-01"},
+		{name: "after max", date: dbtypes.NewDate(2024, time.June, 1), min: min, max: max, want: "2023-12-31"},
+		{name: "on min", date: min, min: min, max: max, want: "2023-01-01"},
+		{name: "on max", date: max, min: min, max: max, want: "2023-12-31"},
+		{name: "min equals max", date: dbtypes.NewDate(2024, time.June, 1), min: max, max: max, want: "2023-12-31"},
+		{name: "unbounded min", date: dbtypes.NewDate(1900, time.June, 1), min: zero, max: max, want: "1900-06-01"},
+		{name: "unbounded max", date: dbtypes.NewDate(2100, time.June, 1), min: min, max: zero, want: "2100-06-01"},
+		{name: "unbounded max clamps to min", date: dbtypes.NewDate(1900, time.June, 1), min: min, max: zero, want: "2023-01-01"},
+		{name: "fully unbounded", date: dbtypes.NewDate(1900, time.June, 1), min: zero, max: zero, want: "1900-06-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Clamp(tt.min, tt.max); got.String() != tt.want {
+				t.Errorf("Clamp() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateClampPanicsOnInvertedRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Clamp() did not panic for min after max")
+		}
+	}()
+
+	dbtypes.Today().Clamp(dbtypes.NewDate(2024, time.January, 1), dbtypes.NewDate(2023, time.January, 1))
+}
+
+func TestDateWeekdayNavigation(t *testing.T) {
+	wednesday := dbtypes.NewDate(2023, time.October, 18)
+
+	tests := []struct {
+		name       string
+		date       dbtypes.Date
+		weekday    time.Weekday
+		wantNext   string
+		wantPrev   string
+		wantAfter  string
+		wantBefore string
+	}{
+		{
+			name: "same day", date: wednesday, weekday: time.Wednesday,
+			wantNext: "2023-10-25", wantPrev: "2023-10-11", wantAfter: "2023-10-18", wantBefore: "2023-10-18",
+		},
+		{
+			name: "later in week", date: wednesday, weekday: time.Friday,
+			wantNext: "2023-10-20", wantPrev: "2023-10-13", wantAfter: "2023-10-20", wantBefore: "2023-10-13",
+		},
+		{
+			name: "earlier in week", date: wednesday, weekday: time.Monday,
+			wantNext: "2023-10-23", wantPrev: "2023-10-16", wantAfter: "2023-10-23", wantBefore: "2023-10-16",
+		},
+		{
+			name: "across month boundary", date: dbtypes.NewDate(2023, time.October, 30), weekday: time.Thursday,
+			wantNext: "2023-11-02", wantPrev: "2023-10-26", wantAfter: "2023-11-02", wantBefore: "2023-10-26",
+		},
+		{
+			name: "across year boundary", date: dbtypes.NewDate(2023, time.December, 30), weekday: time.Monday,
+			wantNext: "2024-01-01", wantPrev: "2023-12-25", wantAfter: "2024-01-01", wantBefore: "2023-12-25",
+		},
+		{
+			name: "back across year boundary", date: dbtypes.NewDate(2024, time.January, 2), weekday: time.Sunday,
+			wantNext: "2024-01-07", wantPrev: "2023-12-31", wantAfter: "2024-01-07", wantBefore: "2023-12-31",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.NextWeekday(tt.weekday); got.String() != tt.wantNext {
+				t.Errorf("NextWeekday(%v) = %s, want %s", tt.weekday, got, tt.wantNext)
+			}
+			if got := tt.date.PrevWeekday(tt.weekday); got.String() != tt.wantPrev {
+				t.Errorf("PrevWeekday(%v) = %s, want %s", tt.weekday, got, tt.wantPrev)
+			}
+			if got := tt.date.OnOrAfter(tt.weekday); got.String() != tt.wantAfter {
+				t.Errorf("OnOrAfter(%v) = %s, want %s", tt.weekday, got, tt.wantAfter)
+			}
+			if got := tt.date.OnOrBefore(tt.weekday); got.String() != tt.wantBefore {
+				t.Errorf("OnOrBefore(%v) = %s, want %s", tt.weekday, got, tt.wantBefore)
+			}
+		})
+	}
+}
+
+func TestMonthGrid(t *testing.T) {
+	tests := []struct {
+		name      string
+		year      int
+		month     time.Month
+		firstDay  time.Weekday
+		wantRows  int
+		wantFirst string
+		wantLast  string
+	}{
+		{
+			name: "leap february starting on first day", year: 2032, month: time.February, firstDay: time.Sunday,
+			wantRows: 5, wantFirst: "2032-02-01", wantLast: "2032-03-06",
+		},
+		{
+			name: "non-leap february in four rows", year: 2026, month: time.February, firstDay: time.Sunday,
+			wantRows: 4, wantFirst: "2026-02-01", wantLast: "2026-02-28",
+		},
+		{
+			name: "six rows", year: 2023, month: time.October, firstDay: time.Monday,
+			wantRows: 6, wantFirst: "2023-09-25", wantLast: "2023-11-05",
+		},
+		{
+			name: "five rows with sunday start", year: 2023, month: time.October, firstDay: time.Sunday,
+			wantRows: 5, wantFirst: "2023-10-01", wantLast: "2023-11-04",
+		},
+		{
+			name: "across year boundary", year: 2024, month: time.December, firstDay: time.Monday,
+			wantRows: 6, wantFirst: "2024-11-25", wantLast: "2025-01-05",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grid := dbtypes.MonthGrid(tt.year, tt.month, tt.firstDay)
+			if len(grid) != tt.wantRows {
+				t.Fatalf("MonthGrid() has %d rows, want %d", len(grid), tt.wantRows)
+			}
+			if got := grid[0][0].String(); got != tt.wantFirst {
+				t.Errorf("MonthGrid()[0][0] = %s, want %s", got, tt.wantFirst)
+			}
+			if got := grid[len(grid)-1][6].String(); got != tt.wantLast {
+				t.Errorf("MonthGrid() last day = %s, want %s", got, tt.wantLast)
+			}
+
+			padded := dbtypes.MonthGridZeroPadded(tt.year, tt.month, tt.firstDay)
+			if len(padded) != len(grid) {
+				t.Fatalf("MonthGridZeroPadded() has %d rows, want %d", len(padded), len(grid))
+			}
+
+			var prev dbtypes.Date
+			inMonth := 0
+			for i, row := range grid {
+				if row[0].Weekday() != tt.firstDay {
+					t.Errorf("row %d starts on %s, want %s", i, row[0].Weekday(), tt.firstDay)
+				}
+				for j, date := range row {
+					if !prev.IsZero() && !date.Equal(prev.Next()) {
+						t.Errorf("grid[%d][%d] = %s does not follow %s", i, j, date, prev)
+					}
+					prev = date
+
+					if time.Month(date.Month()) == tt.month {
+						inMonth++
+						if !padded[i][j].Equal(date) {
+							t.Errorf("padded[%d][%d] = %s, want %s", i, j, padded[i][j], date)
+						}
+					} else if !padded[i][j].IsZero() {
+						t.Errorf("padded[%d][%d] = %s, want zero date", i, j, padded[i][j])
+					}
+				}
+			}
+			if want := dbtypes.NewDate(tt.year, tt.month, 1).DaysInMonth(); inMonth != want {
+				t.Errorf("MonthGrid() has %d days of the month, want %d", inMonth, want)
+			}
+		})
+	}
+}
+
+func TestDateAnniversary(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     string
+		ref      string
+		rule     dbtypes.LeapDayRule
+		wantNext string
+		wantPrev string
+	}{
+		{name: "anniversary today", date: "1990-06-15", ref: "2023-06-15", wantNext: "2023-06-15", wantPrev: "2023-06-15"},
+		{name: "later this year", date: "1990-06-15", ref: "2023-03-01", wantNext: "2023-06-15", wantPrev: "2022-06-15"},
+		{name: "already passed", date: "1990-06-15", ref: "2023-06-16", wantNext: "2024-06-15", wantPrev: "2023-06-15"},
+		{name: "day before", date: "1990-06-15", ref: "2023-06-14", wantNext: "2023-06-15", wantPrev: "2022-06-15"},
+		{name: "end of year", date: "1990-12-31", ref: "2023-12-31", wantNext: "2023-12-31", wantPrev: "2023-12-31"},
+		{name: "start of year", date: "1990-01-01", ref: "2023-12-31", wantNext: "2024-01-01", wantPrev: "2023-01-01"},
+		{name: "leap day in leap year", date: "2000-02-29", ref: "2024-01-10", wantNext: "2024-02-29", wantPrev: "2023-02-28"},
+		{name: "leap day feb 28", date: "2000-02-29", ref: "2023-02-28", wantNext: "2023-02-28", wantPrev: "2023-02-28"},
+		{name: "leap day after feb 28", date: "2000-02-29", ref: "2023-03-01", wantNext: "2024-02-29", wantPrev: "2023-02-28"},
+		{
+			name: "leap day mar 1", date: "2000-02-29", ref: "2023-02-28", rule: dbtypes.LeapDayMar1,
+			wantNext: "2023-03-01", wantPrev: "2022-03-01",
+		},
+		{
+			name: "leap day mar 1 on the day", date: "2000-02-29", ref: "2023-03-01", rule: dbtypes.LeapDayMar1,
+			wantNext: "2023-03-01", wantPrev: "2023-03-01",
+		},
+		{
+			name: "leap day mar 1 in leap year", date: "2000-02-29", ref: "2024-03-01", rule: dbtypes.LeapDayMar1,
+			wantNext: "2025-03-01", wantPrev: "2024-02-29",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, ref := dbtypes.MustParseDate(tt.date), dbtypes.MustParseDate(tt.ref)
+
+			if got := date.NextAnniversary(ref, tt.rule); got.String() != tt.wantNext {
+				t.Errorf("%s.NextAnniversary(%s) = %s, want %s", date, ref, got, tt.wantNext)
+			}
+			if got := date.PreviousAnniversary(ref, tt.rule); got.String() != tt.wantPrev {
+				t.Errorf("%s.PreviousAnniversary(%s) = %s, want %s", date, ref, got, tt.wantPrev)
+			}
+		})
+	}
+
+	// The rule is optional and defaults to LeapDayFeb28.
+	leapDay := dbtypes.MustParseDate("2000-02-29")
+	if got := leapDay.NextAnniversary(dbtypes.MustParseDate("2023-01-01")); got.String() != "2023-02-28" {
+		t.Errorf("NextAnniversary() without rule = %s, want 2023-02-28", got)
+	}
+
+	if got := (dbtypes.Date{}).NextAnniversary(dbtypes.Today()); !got.IsZero() {
+		t.Errorf("zero NextAnniversary() = %s, want zero date", got)
+	}
+	if got := dbtypes.Today().PreviousAnniversary(dbtypes.Date{}); !got.IsZero() {
+		t.Errorf("PreviousAnniversary(zero) = %s, want zero date", got)
+	}
+}
@@ -0,0 +1,51 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MarshalCSV implements the gocsv.TypeMarshaller interface.
+// It returns the date as yyyy-mm-dd, or an empty cell for zero dates.
+// Libraries like jszwec/csvutil use MarshalText instead, which is equivalent.
+func (date Date) MarshalCSV() (string, error) {
+	return date.String(), nil
+}
+
+// UnmarshalCSV implements the gocsv.TypeUnmarshaller interface.
+// It accepts the same inputs as Set: an empty cell is the zero date,
+// otherwise the cell is parsed with ParseDate, falling back to ParseDateAny.
+func (date *Date) UnmarshalCSV(s string) error {
+	return date.Set(s)
+}
+
+// MarshalCSV implements the gocsv.TypeMarshaller interface,
+// returning the object as JSON text, or an empty cell if j is nil.
+func (j JSON) MarshalCSV() (string, error) {
+	if j == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(map[string]interface{}(j))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// UnmarshalCSV implements the gocsv.TypeUnmarshaller interface,
+// parsing a cell containing a JSON object. An empty cell sets j to nil.
+func (j *JSON) UnmarshalCSV(s string) error {
+	if strings.TrimSpace(s) == "" {
+		*j = nil
+		return nil
+	}
+
+	m, err := decodeJSONObject([]byte(s))
+	if err != nil {
+		return fmt.Errorf("dbtypes: invalid JSON in CSV cell: %w", err)
+	}
+	*j = JSON(m)
+	return nil
+}
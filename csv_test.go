@@ -0,0 +1,119 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// csvMarshaler mirrors the gocsv TypeMarshaller and TypeUnmarshaller interfaces.
+type csvMarshaler interface {
+	MarshalCSV() (string, error)
+	UnmarshalCSV(string) error
+}
+
+var (
+	_ csvMarshaler             = (*dbtypes.Date)(nil)
+	_ csvMarshaler             = (*dbtypes.JSON)(nil)
+	_ encoding.TextMarshaler   = dbtypes.Date{}
+	_ encoding.TextUnmarshaler = (*dbtypes.Date)(nil)
+)
+
+type csvRow struct {
+	Date dbtypes.Date
+	Meta dbtypes.JSON
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	rows := []csvRow{
+		{Date: dbtypes.NewDateUTC(2015, time.October, 21), Meta: dbtypes.JSON{"name": "doc", "speed": 88.0}},
+		{Date: dbtypes.Date{}, Meta: nil},
+		{Date: dbtypes.NewDateUTC(1955, time.November, 5), Meta: dbtypes.JSON{}},
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, row := range rows {
+		date, err := row.Date.MarshalCSV()
+		if err != nil {
+			t.Fatalf("Date.MarshalCSV() returned error: %v", err)
+		}
+		meta, err := row.Meta.MarshalCSV()
+		if err != nil {
+			t.Fatalf("JSON.MarshalCSV() returned error: %v", err)
+		}
+		if err := w.Write([]string{date, meta}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll() returned error: %v", err)
+	}
+
+	var got []csvRow
+	for _, record := range records {
+		var row csvRow
+		if err := row.Date.UnmarshalCSV(record[0]); err != nil {
+			t.Fatalf("Date.UnmarshalCSV(%q) returned error: %v", record[0], err)
+		}
+		if err := row.Meta.UnmarshalCSV(record[1]); err != nil {
+			t.Fatalf("JSON.UnmarshalCSV(%q) returned error: %v", record[1], err)
+		}
+		got = append(got, row)
+	}
+
+	if !reflect.DeepEqual(got, rows) {
+		t.Errorf("round trip = %v, want %v", got, rows)
+	}
+}
+
+func TestDateUnmarshalCSV(t *testing.T) {
+	tests := []struct {
+		cell    string
+		want    string
+		wantErr error
+	}{
+		{cell: "2015-10-21", want: "2015-10-21"},
+		{cell: "21/10/2015", want: "2015-10-21"},
+		{cell: "Oct 21, 2015", want: "2015-10-21"},
+		{cell: "", want: ""},
+		{cell: "   ", want: ""},
+		{cell: "2015-02-30", wantErr: dbtypes.ErrDateOutOfRange},
+		{cell: "not a date", wantErr: dbtypes.ErrInvalidDateFormat},
+	}
+
+	for _, tt := range tests {
+		date := dbtypes.NewDateUTC(2000, time.January, 1)
+		err := date.UnmarshalCSV(tt.cell)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalCSV(%q) error = %v, want %v", tt.cell, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("UnmarshalCSV(%q) returned error: %v", tt.cell, err)
+		}
+		if date.String() != tt.want {
+			t.Errorf("UnmarshalCSV(%q) = %s, want %s", tt.cell, date, tt.want)
+		}
+	}
+}
+
+func TestJSONUnmarshalCSVInvalid(t *testing.T) {
+	for _, cell := range []string{"{", "[1,2]", `"text"`} {
+		var j dbtypes.JSON
+		if err := j.UnmarshalCSV(cell); err == nil {
+			t.Errorf("UnmarshalCSV(%q) error = nil, want error", cell)
+		}
+	}
+}
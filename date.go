@@ -4,24 +4,99 @@ import (
 	"bytes"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 )
 
 type Date time.Time
 
-const layout = "2006-01-02"
+// DateLayout is the layout used to marshal and parse dates (yyyy-mm-dd).
+const DateLayout = "2006-01-02"
 
+// zeroDateAsNull controls whether Date.Value stores zero dates as NULL.
+var zeroDateAsNull bool
+
+// ZeroDateAsNull configures Date.Value to return NULL (nil) for zero dates
+// instead of 0001-01-01. It is disabled by default for backwards compatibility.
+// This should be called once at program startup, before any dates are written.
+func ZeroDateAsNull(enable bool) {
+	zeroDateAsNull = enable
+}
+
+// scanLayouts are the layouts tried in order when a text-protocol driver
+// hands a DATE or DATETIME column to Scan as a string or []byte.
+var scanLayouts = []string{
+	DateLayout,
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Scan implements the sql.Scanner interface.
+// Besides time.Time and nil, it accepts string and []byte values
+// like "2015-10-21" as delivered by text-protocol drivers (SQLite, MySQL).
+// Timestamps are truncated to the calendar date in their own location.
+// Integer and float values are treated as Unix timestamps (see DateFromUnix);
+// NaN, infinite and out of range floats return an error wrapping ErrDateOutOfRange.
 func (date *Date) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case nil:
+		*date = Date{}
+		return nil
+	case string:
+		return date.scanText(v)
+	case []byte:
+		return date.scanText(string(v))
+	case int64:
+		*date = DateFromUnix(v)
+		return nil
+	case float64:
+		sec := math.Floor(v)
+		if math.IsNaN(sec) || math.IsInf(sec, 0) || sec < math.MinInt64 || sec >= math.MaxInt64 {
+			return fmt.Errorf("Date.Scan: Unix time %v: %w", v, ErrDateOutOfRange)
+		}
+		*date = DateFromUnix(int64(sec))
+		return nil
+	}
+
 	nullTime := &sql.NullTime{}
 	err = nullTime.Scan(value)
 	*date = Date(nullTime.Time)
 	return
 }
 
+func (date *Date) scanText(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		*date = Date{}
+		return nil
+	}
+
+	for _, layout := range scanLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			y, m, d := t.Date()
+			*date = Date(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+			return nil
+		}
+	}
+	return &ParseError{Input: value, Layout: DateLayout, Err: ErrInvalidDateFormat}
+}
+
+// Value implements the driver.Valuer interface.
+// Zero dates are stored as NULL if ZeroDateAsNull(true) has been called.
 func (date Date) Value() (driver.Value, error) {
+	if zeroDateAsNull && date.IsZero() {
+		return nil, nil
+	}
+
 	y, m, d := time.Time(date).Date()
 	return time.Date(y, m, d, 0, 0, 0, 0, time.Time(date).Location()), nil
 }
@@ -31,71 +106,286 @@ func (date Date) GormDataType() string {
 	return "date"
 }
 
+// dateBinaryVersion is the first byte of the MarshalBinary encoding.
+// It is distinct from the version bytes (1 and 2) used by time.Time's binary
+// encoding so that payloads produced by older versions can still be decoded.
+const dateBinaryVersion byte = 0x80
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The date is encoded as a version byte followed by the year as a varint,
+// the month and the day. The zero date is encoded as the version byte only.
+// The time of day and location are not encoded.
+func (date Date) MarshalBinary() ([]byte, error) {
+	if date.IsZero() {
+		return []byte{dateBinaryVersion}, nil
+	}
+
+	y, m, d := time.Time(date).Date()
+	b := make([]byte, 0, 1+binary.MaxVarintLen64+2)
+	b = append(b, dateBinaryVersion)
+	b = binary.AppendVarint(b, int64(y))
+	b = append(b, byte(m), byte(d))
+	return b, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Dates are decoded at midnight UTC, like UnmarshalJSON and ParseDate.
+// Data produced by time.Time's MarshalBinary (the previous gob encoding) is also accepted.
+func (date *Date) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("Date.UnmarshalBinary: no data")
+	}
+
+	if data[0] != dateBinaryVersion {
+		return (*time.Time)(date).UnmarshalBinary(data)
+	}
+
+	if len(data) == 1 {
+		*date = Date{}
+		return nil
+	}
+
+	year, n := binary.Varint(data[1:])
+	if n <= 0 || len(data) != 1+n+2 {
+		return errors.New("Date.UnmarshalBinary: invalid length")
+	}
+
+	month, day := time.Month(data[1+n]), int(data[2+n])
+	if month < time.January || month > time.December || day < 1 || day > daysInMonth(int(year), month) {
+		return fmt.Errorf("Date.UnmarshalBinary: %d-%02d-%02d: %w", year, month, day, ErrDateOutOfRange)
+	}
+
+	*date = NewDateUTC(int(year), month, day)
+	return nil
+}
+
+// GobEncode encodes the date using the compact MarshalBinary encoding.
 func (date Date) GobEncode() ([]byte, error) {
-	return time.Time(date).GobEncode()
+	return date.MarshalBinary()
 }
 
+// GobDecode decodes the date, accepting both the MarshalBinary encoding
+// and the time.Time based encoding used by previous versions.
 func (date *Date) GobDecode(b []byte) error {
-	return (*time.Time)(date).GobDecode(b)
+	return date.UnmarshalBinary(b)
 }
 
 // Marshals Date type with the standard date layout.
 // If date is a zero value, it will return null bytes.
 func (date Date) MarshalJSON() ([]byte, error) {
-	datetime := time.Time(date)
-
-	if datetime.IsZero() {
+	if date.IsZero() {
 		return []byte("null"), nil
 	}
 
-	dateBytes, err := datetime.MarshalJSON()
-	if err != nil {
-		return []byte(""), err
+	b := make([]byte, 0, len(DateLayout)+2)
+	b = append(b, '"')
+	b = appendDate(b, date)
+	b = append(b, '"')
+	return b, nil
+}
+
+// appendDate appends the civil date in the yyyy-mm-dd format to b.
+// Years outside 0-9999 are formatted with as many digits as needed
+// (and a leading minus sign for negative years), which ParseDate accepts.
+func appendDate(b []byte, date Date) []byte {
+	y, m, d := date.civil()
+	if y < 0 {
+		b = append(b, '-')
+		y = -y
 	}
 
-	// Transform the date to format of layout in format yyyy-mm-dd
-	dateString := string(dateBytes[1 : len(dateBytes)-1])
-	dateString = fmt.Sprintf("\"%s-%02s-%02s\"", dateString[0:4], dateString[5:7], dateString[8:10])
-	return []byte(dateString), nil
+	b = appendPadded(b, y, 4)
+	b = append(b, '-')
+	b = appendPadded(b, int(m), 2)
+	b = append(b, '-')
+	return appendPadded(b, d, 2)
+}
+
+// appendPadded appends the non-negative n to b, zero-padded to width digits.
+func appendPadded(b []byte, n, width int) []byte {
+	for limit := 10; width > 1; width-- {
+		if n < limit {
+			b = append(b, '0')
+		}
+		limit *= 10
+	}
+	return strconv.AppendInt(b, int64(n), 10)
 }
 
 // Custom Json decoder
 // Called to convert json strings to go types
 func (date *Date) UnmarshalJSON(data []byte) error {
+	// by convention, unmarshalers implement UnmarshalJSON([]byte("null")) as a no-op.
+	// This must be checked before decoding into a string since null is not a string.
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	// Every character of the string may be a 6-byte \uXXXX escape.
+	if len(data) > 6*maxDateInputLength+2 {
+		return &ParseError{Input: truncateInput(string(data)), Layout: DateLayout, Err: ErrDateTooLong}
+	}
+
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("date should be a string, got %v", data)
+		return &ParseError{Input: string(data), Layout: DateLayout, Err: ErrInvalidDateFormat}
 	}
+	return date.UnmarshalText([]byte(s))
+}
 
-	// by convention, unmarshalers implement UnmarshalJSON([]byte("null")) as a no-op.
-	if bytes.Equal(data, []byte("null")) {
-		return nil
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the date as yyyy-mm-dd, or empty text for zero dates.
+func (date Date) MarshalText() ([]byte, error) {
+	if date.IsZero() {
+		return []byte{}, nil
 	}
+	return appendDate(make([]byte, 0, len(DateLayout)), date), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the same inputs as UnmarshalJSON without the quotes.
+// Empty text sets the date to the zero value.
+func (date *Date) UnmarshalText(text []byte) error {
+	s := string(text)
 
 	// If s is an empty string, assume a zero-value to allow for optional date.
 	if strings.TrimSpace(s) == "" {
-		s = "0001-01-01"
+		*date = Date{}
+		return nil
 	}
 
-	// Make sure that the user has provided the standard date format
-	_, err := time.Parse(layout, s)
+	parsedDate, err := ParseDate(s)
 	if err != nil {
-		return fmt.Errorf("date should be of the format: yyyy-mm-dd")
+		// Fall back to RFC3339 timestamps sent by frontends for date fields.
+		var ok bool
+		parsedDate, ok = parseTimestampDate(s)
+		if !ok {
+			return err
+		}
+	}
+
+	*date = parsedDate
+	return nil
+}
+
+// parseTimestampDate parses an RFC3339 timestamp like "2015-10-21T08:30:00+03:00"
+// and returns its calendar day in the timestamp's own zone at midnight UTC.
+// Timestamps without a zone like "2015-10-21T08:30" fall back to their
+// yyyy-mm-ddT prefix, as long as the rest is a valid wall clock time.
+func parseTimestampDate(s string) (Date, bool) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		y, m, d := t.Date()
+		return Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)), true
+	}
+
+	if len(s) > len(DateLayout) && s[len(DateLayout)] == 'T' && isWallClock(s[len(DateLayout)+1:]) {
+		if t, err := time.Parse(DateLayout, s[:len(DateLayout)]); err == nil {
+			return Date(t), true
+		}
+	}
+	return Date{}, false
+}
+
+// isWallClock reports whether s is a time of day like "08:30", "08:30:00"
+// or "08:30:00.123" without a zone.
+func isWallClock(s string) bool {
+	if len(s) < 5 || !isDigits(s[:2]) || s[2] != ':' || !isDigits(s[3:5]) {
+		return false
+	}
+
+	s = s[5:]
+	if s == "" {
+		return true
+	}
+	if len(s) < 3 || s[0] != ':' || !isDigits(s[1:3]) {
+		return false
+	}
+
+	s = s[3:]
+	return s == "" || (s[0] == '.' && isDigits(s[1:]))
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and v3.
+// It returns the date as yyyy-mm-dd, or nil (null) for zero dates.
+func (date Date) MarshalYAML() (interface{}, error) {
+	if date.IsZero() {
+		return nil, nil
+	}
+	return date.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2
+// (also honoured by v3). It accepts quoted and unquoted dates in the formats
+// accepted by UnmarshalText as well as YAML timestamps, whose calendar date is
+// taken in the timestamp's own zone at midnight UTC. A null value is a no-op.
+func (date *Date) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
 	}
 
-	// Convert date string to the standard format to RFC 3339 format
-	s = fmt.Sprintf("\"%sT00:00:00Z\"", s)
-	return (*time.Time)(date).UnmarshalJSON([]byte(s))
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		*date = NewDateUTC(v.Date())
+		return nil
+	case string:
+		err := date.UnmarshalText([]byte(v))
+		if err == nil {
+			return nil
+		}
+
+		// Fall back to the YAML timestamp formats, e.g. "2001-12-14 21:59:43.10".
+		var t time.Time
+		if unmarshal(&t) != nil {
+			return err
+		}
+		*date = NewDateUTC(t.Date())
+		return nil
+	default:
+		return &ParseError{Input: fmt.Sprint(v), Layout: DateLayout, Err: ErrInvalidDateFormat}
+	}
 }
 
 // Implement a FormScanner interface to be parsed from a
 // multipart/form or www-x-urlencoded form.
+// value may be a string, []byte, []string (the first element is used),
+// time.Time (truncated to its date) or a fmt.Stringer.
 // If value is an empty string, no parsing is performed.
 // You should validate the date after parsing the form/json.
 // See https://github.com/abiiranathan/egor.git
 func (date *Date) FormScan(value interface{}) error {
-	dateStr, ok := value.(string)
-	if !ok {
+	var dateStr string
+	switch v := value.(type) {
+	case string:
+		dateStr = v
+	case []byte:
+		dateStr = string(v)
+	case []string:
+		if len(v) > 0 {
+			dateStr = v[0]
+		}
+	case time.Time:
+		y, m, d := v.Date()
+		*date = Date(time.Date(y, m, d, 0, 0, 0, 0, v.Location()))
+		return nil
+	case fmt.Stringer:
+		dateStr = v.String()
+	default:
 		return fmt.Errorf("invalid date. Expected value as a string")
 	}
 
@@ -104,7 +394,7 @@ func (date *Date) FormScan(value interface{}) error {
 		return nil
 	}
 
-	parsedDate, err := ParseDateFromString(dateStr)
+	parsedDate, err := ParseDate(dateStr)
 	if err != nil {
 		return err
 	}
@@ -125,6 +415,44 @@ func (date Date) Day() int {
 	return time.Time(date).Day()
 }
 
+// Weekday returns the day of the week of the date.
+func (date Date) Weekday() time.Weekday {
+	return time.Time(date).Weekday()
+}
+
+// weekend holds the days considered part of the weekend, indexed by time.Weekday.
+var weekend = defaultWeekend()
+
+func defaultWeekend() [7]bool {
+	var days [7]bool
+	days[time.Saturday] = true
+	days[time.Sunday] = true
+	return days
+}
+
+// SetWeekend sets the days considered part of the weekend by IsWeekend,
+// e.g. SetWeekend(time.Friday, time.Saturday). The default is Saturday and Sunday,
+// which is restored by calling SetWeekend with no arguments. Days outside
+// Sunday to Saturday are taken modulo 7, so time.Weekday(-1) is Saturday.
+// This should be called once at program startup.
+func SetWeekend(days ...time.Weekday) {
+	if len(days) == 0 {
+		weekend = defaultWeekend()
+		return
+	}
+
+	var newWeekend [7]bool
+	for _, day := range days {
+		newWeekend[(day%7+7)%7] = true
+	}
+	weekend = newWeekend
+}
+
+// IsWeekend reports whether the date falls on a weekend day (see SetWeekend).
+func (date Date) IsWeekend() bool {
+	return weekend[date.Weekday()]
+}
+
 func (date Date) Format(layout string) string {
 	if date.IsZero() {
 		return ""
@@ -132,51 +460,460 @@ func (date Date) Format(layout string) string {
 	return time.Time(date).Format(layout)
 }
 
+// String returns the date formatted as yyyy-mm-dd with the year padded
+// to at least 4 digits, matching MarshalJSON. Zero dates return an empty string.
 func (date Date) String() string {
-	return fmt.Sprintf("%d-%02d-%02d", date.Year(), date.Month(), date.Day())
+	if date.IsZero() {
+		return ""
+	}
+	return time.Time(date).Format(DateLayout)
+}
+
+// defaultLocation is the location used by NewDate and Today.
+var defaultLocation = time.Local
+
+// SetDefaultLocation sets the location used by NewDate and Today.
+// It defaults to time.Local. A nil loc resets it to time.Local.
+// This should be called once at program startup.
+func SetDefaultLocation(loc *time.Location) {
+	if loc == nil {
+		loc = time.Local
+	}
+	defaultLocation = loc
 }
 
+// NewDate returns the date at midnight in the default location (see SetDefaultLocation).
 func NewDate(year int, month time.Month, day int) Date {
-	return Date(time.Date(year, month, day, 0, 0, 0, 0, time.Local))
+	return NewDateIn(year, month, day, defaultLocation)
 }
 
-func ParseDateFromString(dateStr string) (Date, error) {
-	var date Date
+// NewDateUTC returns the date at midnight UTC.
+func NewDateUTC(year int, month time.Month, day int) Date {
+	return NewDateIn(year, month, day, time.UTC)
+}
+
+// NewDateIn returns the date at midnight in loc.
+// If midnight does not exist in loc because of a DST transition,
+// the first instant of that day is used instead.
+func NewDateIn(year int, month time.Month, day int, loc *time.Location) Date {
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+
+	// Normalize out-of-range components (e.g. Feb 30) the same way time.Date does.
+	wantY, wantM, wantD := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Date()
+	if y, m, d := t.Date(); y != wantY || m != wantM || d != wantD {
+		_, end := t.ZoneBounds()
+		t = end
+	}
+	return Date(t)
+}
+
+// DateFromTime returns the calendar date of t at midnight in t's location.
+// Unlike a plain Date(t) conversion, the time of day is discarded so that
+// Value, Equal and the binary encodings see a canonical date.
+func DateFromTime(t time.Time) Date {
+	y, m, d := t.Date()
+	return NewDateIn(y, m, d, t.Location())
+}
+
+// Unix returns the Unix time in seconds of midnight (or the first instant
+// of the day if midnight does not exist) on the date in the date's location.
+func (date Date) Unix() int64 {
+	return time.Time(DateFromTime(time.Time(date))).Unix()
+}
+
+// At returns the time at hour:min:sec on the date in loc, or in the date's own
+// location if loc is nil. Out of range values are normalized and DST
+// transitions are resolved by time.Date: a wall time skipped by a transition
+// is interpreted with the offset in effect after it, so 02:30 on a
+// spring-forward day in New York is 02:30 EDT, i.e. 01:30 EST.
+// A wall time repeated by a transition resolves to its first occurrence.
+func (date Date) At(hour, min, sec int, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Time(date).Location()
+	}
+	y, m, d := date.civil()
+	return time.Date(y, m, d, hour, min, sec, 0, loc)
+}
+
+// StartOfDayIn returns the first instant of the date in loc, or in the date's
+// own location if loc is nil. This is midnight unless a DST transition
+// skips midnight, in which case it is the end of the gap.
+func (date Date) StartOfDayIn(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Time(date).Location()
+	}
+	y, m, d := date.civil()
+	return time.Time(NewDateIn(y, m, d, loc))
+}
 
-	b, err := json.Marshal(dateStr)
+// EndOfDayIn returns the last instant of the date in loc (23:59:59.999999999
+// on most days), or in the date's own location if loc is nil.
+// Together with StartOfDayIn it gives inclusive bounds for a BETWEEN query.
+func (date Date) EndOfDayIn(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.Time(date).Location()
+	}
+	y, m, d := date.civil()
+	return time.Time(NewDateIn(y, m, d+1, loc)).Add(-time.Nanosecond)
+}
+
+// DateFromUnix returns the calendar date in UTC of the Unix timestamp sec.
+// Any time of day is discarded.
+func DateFromUnix(sec int64) Date {
+	y, m, d := time.Unix(sec, 0).UTC().Date()
+	return Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// dateLayouts are additional layouts accepted by ParseDate after DateLayout.
+var dateLayouts []string
+
+// SetDateLayouts sets additional layouts (in Go reference format, e.g. "02/01/2006")
+// that ParseDate, Date.UnmarshalJSON and Date.FormScan accept.
+//
+// Layouts are tried in the order given, always after DateLayout, and the first
+// layout that parses the input wins. An ambiguous input like "02/03/2023" is
+// therefore resolved by layout priority. Calling SetDateLayouts with no
+// arguments restores the default of accepting DateLayout only.
+// This should be called once at program startup.
+func SetDateLayouts(layouts ...string) {
+	dateLayouts = append([]string(nil), layouts...)
+}
+
+// ParseDate parses a date string in the yyyy-mm-dd format (DateLayout)
+// or any of the layouts registered with SetDateLayouts. Years outside
+// 0000-9999 are accepted in the form produced by MarshalJSON, e.g. "10000-10-21".
+// Errors are of type *ParseError wrapping ErrEmptyDate, ErrDateTooLong,
+// ErrInvalidDateFormat or ErrDateOutOfRange.
+func ParseDate(dateStr string) (Date, error) {
+	if strings.TrimSpace(dateStr) == "" {
+		return Date{}, &ParseError{Input: dateStr, Layout: DateLayout, Err: ErrEmptyDate}
+	}
+	if err := checkDateInput(dateStr); err != nil {
+		return Date{}, err
+	}
+
+	// Make sure that the user has provided the standard date format
+	t, err := time.Parse(DateLayout, dateStr)
+	if err == nil {
+		return Date(t), nil
+	}
+	if date, ok := parseExtendedDate(dateStr); ok {
+		return date, nil
+	}
+	parseErr := &ParseError{Input: dateStr, Layout: DateLayout, Err: parseErrorCause(err)}
+
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, dateStr); err == nil {
+			if date, ok := parsedDate(t, layout); ok {
+				return date, nil
+			}
+		}
+	}
+	return Date{}, parseErr
+}
+
+// maxDateInputLength is the maximum length of a string accepted by ParseDate.
+// It leaves room for long custom layouts while bounding the work done on
+// hostile input.
+const maxDateInputLength = 64
+
+// checkDateInput rejects inputs that are too long to be a date
+// or contain control characters like NUL.
+func checkDateInput(s string) error {
+	if len(s) > maxDateInputLength {
+		return &ParseError{Input: truncateInput(s), Layout: DateLayout, Err: ErrDateTooLong}
+	}
+	if strings.ContainsFunc(s, unicode.IsControl) {
+		return &ParseError{Input: s, Layout: DateLayout, Err: ErrInvalidDateFormat}
+	}
+	return nil
+}
+
+// truncateInput shortens s to maxDateInputLength bytes for error messages.
+func truncateInput(s string) string {
+	if len(s) <= maxDateInputLength {
+		return s
+	}
+	return s[:maxDateInputLength] + "..."
+}
+
+// parseExtendedDate parses dates with years outside 0000-9999 as formatted
+// by MarshalJSON, like "10000-10-21" or "-0044-03-15".
+func parseExtendedDate(s string) (Date, bool) {
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+
+	yearStr, rest, ok := strings.Cut(s, "-")
+	if !ok || len(yearStr) < 4 || len(yearStr) > 9 || (!neg && len(yearStr) == 4) ||
+		!isDigits(yearStr) || len(rest) != 5 || rest[2] != '-' || !isDigits(rest[:2]) || !isDigits(rest[3:]) {
+		return Date{}, false
+	}
+
+	year, _ := strconv.Atoi(yearStr)
+	month, _ := strconv.Atoi(rest[:2])
+	day, _ := strconv.Atoi(rest[3:])
+	if neg {
+		year = -year
+	}
+
+	if month < 1 || month > 12 || day < 1 || day > daysInMonth(year, time.Month(month)) {
+		return Date{}, false
+	}
+	return Date(time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)), true
+}
+
+// parseErrorCause maps an error from time.Parse to ErrDateOutOfRange
+// or ErrInvalidDateFormat.
+func parseErrorCause(err error) error {
+	var timeErr *time.ParseError
+	if errors.As(err, &timeErr) && strings.HasSuffix(timeErr.Message, "out of range") {
+		return ErrDateOutOfRange
+	}
+	return ErrInvalidDateFormat
+}
+
+// MustParseDate is like ParseDate but panics if s cannot be parsed.
+// It simplifies initialization of fixtures and package-level variables.
+func MustParseDate(s string) Date {
+	date, err := ParseDate(s)
 	if err != nil {
-		return date, err
+		panic(err)
 	}
+	return date
+}
 
-	err = date.UnmarshalJSON(b)
+// ParseDateFromString is equivalent to ParseDate.
+func ParseDateFromString(dateStr string) (Date, error) {
+	return ParseDate(dateStr)
+}
+
+// ParseDateLayout parses value with a Go reference layout (e.g. "02 Jan 2006")
+// and returns the date at midnight UTC. Any time of day in the layout is parsed
+// and discarded; a zone offset does not shift the calendar date.
+// Layouts without a day component (e.g. "2006-01") are rejected with ErrLayoutNoDay
+// rather than defaulting to the first of the month.
+// Errors are of type *ParseError.
+func ParseDateLayout(value, layout string) (Date, error) {
+	if !layoutHasDay(layout) {
+		return Date{}, &ParseError{Input: value, Layout: layout, Err: ErrLayoutNoDay}
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return Date{}, &ParseError{Input: value, Layout: layout, Err: ErrEmptyDate}
+	}
+
+	t, err := time.Parse(layout, value)
 	if err != nil {
-		return date, err
+		return Date{}, &ParseError{Input: value, Layout: layout, Err: parseErrorCause(err)}
+	}
+
+	date, ok := parsedDate(t, layout)
+	if !ok {
+		return Date{}, &ParseError{Input: value, Layout: layout, Err: ErrDateOutOfRange}
 	}
 	return date, nil
 }
 
+// twoDigitYearPivot is the two-digit year from which years map to the 1900s.
+var twoDigitYearPivot = 69
+
+// SetTwoDigitYearPivot sets how two-digit years ("06" in a layout, %y in
+// ParseStrftime) are expanded: years below pivot map to 20yy and years at or
+// above it map to 19yy. The default of 69 matches POSIX, so 68 is 2068 and
+// 69 is 1969. A pivot of 0 maps every year to the 1900s and 100 maps every year
+// to the 2000s. It panics if pivot is outside [0, 100].
+//
+// The pivot applies to ParseDateLayout, ParseDateAny, ParseStrftime and the
+// layouts registered with SetDateLayouts, and therefore to JSON and form parsing.
+// This should be called once at program startup.
+func SetTwoDigitYearPivot(pivot int) {
+	if pivot < 0 || pivot > 100 {
+		panic(fmt.Sprintf("dbtypes: two-digit year pivot %d out of range [0, 100]", pivot))
+	}
+	twoDigitYearPivot = pivot
+}
+
+// parsedDate returns the civil date of t parsed with layout at midnight UTC,
+// expanding a two-digit year with the configured pivot.
+// It reports false if the day does not exist in the expanded year (29 February).
+func parsedDate(t time.Time, layout string) (Date, bool) {
+	y, m, d := t.Date()
+	if layoutHasTwoDigitYear(layout) {
+		y %= 100
+		if y < twoDigitYearPivot {
+			y += 2000
+		} else {
+			y += 1900
+		}
+		if d > daysInMonth(y, m) {
+			return Date{}, false
+		}
+	}
+	return Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)), true
+}
+
+// layoutHasTwoDigitYear reports whether layout contains the "06" year verb.
+// It skips the other verbs that contain "0" the same way the time package does.
+func layoutHasTwoDigitYear(layout string) bool {
+	for i := 0; i < len(layout); i++ {
+		switch {
+		case strings.HasPrefix(layout[i:], "2006"):
+			i += 3
+		case strings.HasPrefix(layout[i:], "002"):
+			i += 2
+		case layout[i] == '0' && i+1 < len(layout) && layout[i+1] >= '1' && layout[i+1] <= '6':
+			if layout[i+1] == '6' {
+				return true
+			}
+			i++
+		}
+	}
+	return false
+}
+
+// anyDateLayouts is the default priority list of ParseDateAny.
+var anyDateLayouts = []string{
+	DateLayout,
+	time.RFC3339,
+	"02/01/2006",
+	"01/02/2006",
+	"Jan 2, 2006",
+	"20060102",
+}
+
+// ParseDateAny parses value by trying each layout in turn and returns the date
+// together with the layout that matched. Without layouts, the priority list is:
+//
+//	2006-01-02                 ISO 8601 date
+//	2006-01-02T15:04:05Z07:00  RFC 3339 timestamp (time of day discarded)
+//	02/01/2006                 dd/mm/yyyy
+//	01/02/2006                 mm/dd/yyyy
+//	Jan 2, 2006                month name
+//	20060102                   yyyymmdd
+//
+// Because dd/mm/yyyy is tried first, mm/dd/yyyy only matches when the
+// second component is greater than 12, so "02/03/2023" is the 2nd of March
+// while "02/13/2023" is the 13th of February.
+//
+// Errors are of type *ParseError. If no layout matches, an out of range
+// error is preferred over a format error so that "2015-02-30" reports
+// ErrDateOutOfRange.
+func ParseDateAny(value string, layouts ...string) (Date, string, error) {
+	if len(layouts) == 0 {
+		layouts = anyDateLayouts
+	}
+
+	var firstErr error
+	for _, layout := range layouts {
+		date, err := ParseDateLayout(value, layout)
+		if err == nil {
+			return date, layout, nil
+		}
+
+		if errors.Is(err, ErrEmptyDate) {
+			return Date{}, "", err
+		}
+		if firstErr == nil || (errors.Is(err, ErrDateOutOfRange) && !errors.Is(firstErr, ErrDateOutOfRange)) {
+			firstErr = err
+		}
+	}
+
+	if firstErr == nil {
+		firstErr = &ParseError{Input: value, Err: ErrInvalidDateFormat}
+	}
+	return Date{}, "", firstErr
+}
+
+// layoutHasDay reports whether layout contains a day of month or day of year.
+// Two dates a week apart share the weekday, so they only format differently
+// if the layout includes the day itself.
+func layoutHasDay(layout string) bool {
+	a := time.Date(2001, time.February, 3, 0, 0, 0, 0, time.UTC)
+	return a.Format(layout) != a.AddDate(0, 0, 7).Format(layout)
+}
+
+// Today returns the current date in the default location (see SetDefaultLocation).
 func Today() Date {
-	return NewDate(time.Now().Date())
+	return NewDate(time.Now().In(defaultLocation).Date())
+}
+
+// Yesterday returns the day before Today() in the default location.
+func Yesterday() Date {
+	return Today().Prev()
+}
+
+// Tomorrow returns the day after Today() in the default location.
+func Tomorrow() Date {
+	return Today().Next()
+}
+
+// Next returns the following calendar day.
+func (date Date) Next() Date {
+	return date.AddDays(1)
+}
+
+// Prev returns the preceding calendar day.
+func (date Date) Prev() Date {
+	return date.AddDays(-1)
 }
 
 func (date Date) IsZero() bool {
 	return time.Time(date).IsZero()
 }
 
+// civilDays returns the number of days since 1970-01-01 of the date's
+// year, month and day in its own location. Two dates with the same
+// calendar components have the same civilDays regardless of location.
+func (date Date) civilDays() int64 {
+	y, m, d := time.Time(date).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// Equal reports whether date and other fall on the same calendar day.
+// The time of day and location are ignored.
 func (date Date) Equal(other Date) bool {
-	return time.Time(date).Equal(time.Time(other))
+	return date.civilDays() == other.civilDays()
 }
 
+// Compare compares the calendar days of date and other.
+// It returns -1 if date is before other, 0 if they are the same day
+// and +1 if date is after other. Time of day and location are ignored.
+func (date Date) Compare(other Date) int {
+	a, b := date.civilDays(), other.civilDays()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether date is on an earlier calendar day than other.
 func (date Date) Before(other Date) bool {
-	return time.Time(date).Before(time.Time(other))
+	return date.civilDays() < other.civilDays()
 }
 
+// After reports whether date is on a later calendar day than other.
 func (date Date) After(other Date) bool {
-	return time.Time(date).After(time.Time(other))
+	return date.civilDays() > other.civilDays()
 }
 
+// AddDate adds years, months and days to the date like time.Time.AddDate.
+// If the resulting wall clock time does not exist because of a DST transition
+// (e.g. midnight in zones that switch at 00:00), the first instant of the
+// resulting day is returned so that the calendar day is always correct.
 func (date Date) AddDate(years int, months int, days int) Date {
-	return Date(time.Time(date).AddDate(years, months, days))
+	t := time.Time(date).AddDate(years, months, days)
+
+	y, m, d := time.Time(date).Date()
+	wantY, wantM, wantD := time.Date(y+years, m+time.Month(months), d+days, 0, 0, 0, 0, time.UTC).Date()
+	if gotY, gotM, gotD := t.Date(); gotY != wantY || gotM != wantM || gotD != wantD {
+		return NewDateIn(wantY, wantM, wantD, t.Location())
+	}
+	return Date(t)
 }
 
 func (date Date) AddDays(days int) Date {
@@ -193,21 +930,54 @@ func (date Date) AddYears(years int) Date {
 
 // Returns the number of days in the month of the date.
 func (date Date) DaysInMonth() int {
-	nextMonth := time.Time(date).AddDate(0, 1, 0)
-	lastDayOfMonth := time.Date(nextMonth.Year(), nextMonth.Month(), 0, 0, 0, 0, 0, nextMonth.Location())
-	return lastDayOfMonth.Day()
+	y, m, _ := time.Time(date).Date()
+	return daysInMonth(y, m)
 }
 
+// Returns the number of days in the year of the date.
 func (date Date) DaysInYear() int {
-	year := time.Time(date).Year()
-	if (year%4 == 0 && year%100 != 0) || year%400 == 0 {
+	return daysInYear(date.Year())
+}
+
+// IsLeapYear reports whether the date's year is a leap year.
+func (date Date) IsLeapYear() bool {
+	return IsLeapYear(date.Year())
+}
+
+// IsLeapYear reports whether year is a leap year in the proleptic Gregorian calendar.
+func IsLeapYear(year int) bool {
+	return (year%4 == 0 && year%100 != 0) || year%400 == 0
+}
+
+var monthDays = [...]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+func daysInMonth(year int, month time.Month) int {
+	if month == time.February && IsLeapYear(year) {
+		return 29
+	}
+	return monthDays[month-1]
+}
+
+func daysInYear(year int) int {
+	if IsLeapYear(year) {
 		return 366
 	}
 	return 365
 }
 
-// Returns the number of days between the date and the other date.
-// Assumes that the other date is after the date.
+// Returns the number of calendar days between the date and the other date.
+// The result is never negative; use DaysUntil for a signed difference.
 func (date Date) DaysBetween(other Date) int {
-	return int(time.Time(other).Sub(time.Time(date)).Hours() / 24)
+	days := date.DaysUntil(other)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+// Returns the signed number of calendar days from the date to the other date.
+// The result is negative if other is before the date.
+// Time of day and location are ignored so DST transitions don't affect the result.
+func (date Date) DaysUntil(other Date) int {
+	return int(other.civilDays() - date.civilDays())
 }
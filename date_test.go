@@ -1,11 +1,21 @@
 package dbtypes_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"math"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/abiiranathan/dbtypes"
+	"gopkg.in/yaml.v3"
 )
 
 func TestDateMarshal(t *testing.T) {
@@ -178,6 +188,11 @@ func TestDaysInYear(t *testing.T) {
 }
 
 func TestDaysBetween(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
 	tests := []struct {
 		name  string
 		date1 dbtypes.Date
@@ -202,6 +217,36 @@ func TestDaysBetween(t *testing.T) {
 			date2: dbtypes.NewDate(2022, time.January, 1),
 			want:  365,
 		},
+		{
+			name:  "Reversed order",
+			date1: dbtypes.NewDate(2022, time.January, 1),
+			date2: dbtypes.NewDate(2021, time.January, 1),
+			want:  365,
+		},
+		{
+			name:  "Multi-year span with leap years",
+			date1: dbtypes.NewDate(2000, time.January, 1),
+			date2: dbtypes.NewDate(2010, time.January, 1),
+			want:  3653,
+		},
+		{
+			name:  "Spanning DST start",
+			date1: dbtypes.Date(time.Date(2023, time.March, 11, 0, 0, 0, 0, newYork)),
+			date2: dbtypes.Date(time.Date(2023, time.March, 13, 0, 0, 0, 0, newYork)),
+			want:  2,
+		},
+		{
+			name:  "Spanning DST end",
+			date1: dbtypes.Date(time.Date(2023, time.November, 4, 0, 0, 0, 0, newYork)),
+			date2: dbtypes.Date(time.Date(2023, time.November, 6, 0, 0, 0, 0, newYork)),
+			want:  2,
+		},
+		{
+			name:  "Different locations",
+			date1: dbtypes.Date(time.Date(2023, time.May, 1, 0, 0, 0, 0, time.FixedZone("UTC+3", 3*3600))),
+			date2: dbtypes.Date(time.Date(2023, time.May, 2, 0, 0, 0, 0, time.FixedZone("UTC-5", -5*3600))),
+			want:  1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -212,3 +257,1713 @@ func TestDaysBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestDateUnmarshalNull(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "null", data: "null"},
+		{name: "padded null", data: " \n\tnull \r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := dbtypes.NewDate(2015, time.October, 21)
+			if err := date.UnmarshalJSON([]byte(tt.data)); err != nil {
+				t.Fatalf("UnmarshalJSON(%q) returned error: %v", tt.data, err)
+			}
+			if date.String() != "2015-10-21" {
+				t.Errorf("UnmarshalJSON(%q) modified the receiver: %s", tt.data, date)
+			}
+		})
+	}
+}
+
+func TestDateZeroRoundTrip(t *testing.T) {
+	type visit struct {
+		Name      string        `json:"name"`
+		Date      dbtypes.Date  `json:"date"`
+		NextVisit *dbtypes.Date `json:"next_visit"`
+	}
+
+	zero := dbtypes.Date{}
+	tests := []struct {
+		name  string
+		visit visit
+	}{
+		{
+			name:  "zero date",
+			visit: visit{Name: "a"},
+		},
+		{
+			name:  "zero date pointer",
+			visit: visit{Name: "b", NextVisit: &zero},
+		},
+		{
+			name:  "non-zero date",
+			visit: visit{Name: "c", Date: dbtypes.NewDate(2015, time.October, 21)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.visit)
+			if err != nil {
+				t.Fatalf("Failed to marshal struct: %v", err)
+			}
+
+			var got visit
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Failed to unmarshal %s: %v", data, err)
+			}
+
+			if got.Name != tt.visit.Name || !got.Date.Equal(tt.visit.Date) {
+				t.Errorf("Round-trip mismatch: got %+v, want %+v", got, tt.visit)
+			}
+
+			if got.NextVisit != nil {
+				t.Errorf("Expected NextVisit to be nil for null JSON, got %v", got.NextVisit)
+			}
+		})
+	}
+}
+
+func TestDateMarshalYears(t *testing.T) {
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		want string
+	}{
+		{
+			name: "year 1",
+			date: dbtypes.Date(time.Date(1, time.February, 3, 0, 0, 0, 0, time.UTC)),
+			want: `"0001-02-03"`,
+		},
+		{
+			name: "year 99",
+			date: dbtypes.Date(time.Date(99, time.December, 31, 0, 0, 0, 0, time.UTC)),
+			want: `"0099-12-31"`,
+		},
+		{
+			name: "year 999",
+			date: dbtypes.Date(time.Date(999, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			want: `"0999-01-01"`,
+		},
+		{
+			name: "year 10000",
+			date: dbtypes.Date(time.Date(10000, time.October, 21, 0, 0, 0, 0, time.UTC)),
+			want: `"10000-10-21"`,
+		},
+		{
+			name: "negative year",
+			date: dbtypes.Date(time.Date(-44, time.March, 15, 0, 0, 0, 0, time.UTC)),
+			want: `"-0044-03-15"`,
+		},
+		{
+			name: "zero date",
+			date: dbtypes.Date{},
+			want: "null",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.date)
+			if err != nil {
+				t.Fatalf("Failed to marshal Date: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateValueZeroAsNull(t *testing.T) {
+	dbtypes.ZeroDateAsNull(true)
+	defer dbtypes.ZeroDateAsNull(false)
+
+	value, err := dbtypes.Date{}.Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if value != nil {
+		t.Errorf("Value() = %v, want nil", value)
+	}
+
+	value, err = dbtypes.NewDate(2015, time.October, 21).Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if _, ok := value.(time.Time); !ok {
+		t.Errorf("Value() = %T, want time.Time", value)
+	}
+}
+
+func TestDateScanValueRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		asNull   bool
+		date     dbtypes.Date
+		wantNull bool
+	}{
+		{name: "non-zero date", date: dbtypes.NewDate(2015, time.October, 21)},
+		{name: "zero date stored as 0001-01-01", date: dbtypes.Date{}},
+		{name: "zero date stored as NULL", asNull: true, date: dbtypes.Date{}, wantNull: true},
+		{name: "non-zero date with null option", asNull: true, date: dbtypes.NewDate(2020, time.February, 29)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtypes.ZeroDateAsNull(tt.asNull)
+			defer dbtypes.ZeroDateAsNull(false)
+
+			db := openFakeDB(t)
+			if _, err := db.Exec("INSERT INTO visits VALUES (?)", tt.date); err != nil {
+				t.Fatalf("Failed to insert date: %v", err)
+			}
+
+			stored := fakeRowValues(t)[0][0]
+			if (stored == nil) != tt.wantNull {
+				t.Errorf("Stored value = %v, want NULL: %v", stored, tt.wantNull)
+			}
+
+			var got dbtypes.Date
+			if err := db.QueryRow("SELECT date FROM visits").Scan(&got); err != nil {
+				t.Fatalf("Failed to scan date: %v", err)
+			}
+			if !got.Equal(tt.date) {
+				t.Errorf("Scanned date = %s, want %s", got, tt.date)
+			}
+		})
+	}
+}
+
+func TestDateScanValueKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		year    int
+		month   int
+		day     int
+		zero    bool
+		wantErr bool
+	}{
+		{name: "time.Time", value: time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC), year: 2015, month: 10, day: 21},
+		{name: "nil", value: nil, zero: true},
+		{name: "string date", value: "2015-10-21", year: 2015, month: 10, day: 21},
+		{name: "bytes date", value: []byte("2015-10-21"), year: 2015, month: 10, day: 21},
+		{name: "RFC3339 string", value: "2015-10-21T23:30:00+03:00", year: 2015, month: 10, day: 21},
+		{name: "RFC3339 bytes", value: []byte("2015-10-21T08:00:00Z"), year: 2015, month: 10, day: 21},
+		{name: "datetime string", value: "2015-10-21 13:45:00", year: 2015, month: 10, day: 21},
+		{name: "sqlite datetime string", value: "2015-10-21 13:45:00.123+02:00", year: 2015, month: 10, day: 21},
+		{name: "empty string", value: "", zero: true},
+		{name: "invalid string", value: "21/10/2015", wantErr: true},
+		{name: "invalid bytes", value: []byte("not a date"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var date dbtypes.Date
+			err := date.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if tt.zero {
+				if !date.IsZero() {
+					t.Errorf("Scan(%v) = %s, want zero date", tt.value, date)
+				}
+				return
+			}
+
+			if date.Year() != tt.year || date.Month() != tt.month || date.Day() != tt.day {
+				t.Errorf("Scan(%v) = %s, want %04d-%02d-%02d", tt.value, date, tt.year, tt.month, tt.day)
+			}
+
+			tm := time.Time(date)
+			if tm.Hour() != 0 || tm.Minute() != 0 || tm.Second() != 0 || tm.Nanosecond() != 0 {
+				t.Errorf("Scan(%v) kept a time of day: %v", tt.value, tm)
+			}
+		})
+	}
+}
+
+func TestDateFromUnix(t *testing.T) {
+	tests := []struct {
+		name string
+		sec  int64
+		want string
+	}{
+		{name: "epoch", sec: 0, want: "1970-01-01"},
+		{name: "midnight", sec: 1445385600, want: "2015-10-21"},
+		{name: "time of day", sec: 1445385600 + 23*3600 + 59*60, want: "2015-10-21"},
+		{name: "negative", sec: -1, want: "1969-12-31"},
+		{name: "negative whole day", sec: -86400, want: "1969-12-31"},
+		{name: "negative with time of day", sec: -86401, want: "1969-12-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dbtypes.DateFromUnix(tt.sec); got.String() != tt.want {
+				t.Errorf("DateFromUnix(%d) = %s, want %s", tt.sec, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateScanUnix(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "int64 epoch", value: int64(0), want: "1970-01-01"},
+		{name: "int64 with time of day", value: int64(1445385600 + 12*3600), want: "2015-10-21"},
+		{name: "int64 negative", value: int64(-3600), want: "1969-12-31"},
+		{name: "float64", value: float64(1445385600) + 0.75, want: "2015-10-21"},
+		{name: "float64 negative", value: -0.5, want: "1969-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var date dbtypes.Date
+			if err := date.Scan(tt.value); err != nil {
+				t.Fatalf("Scan(%v) returned error: %v", tt.value, err)
+			}
+			if date.String() != tt.want {
+				t.Errorf("Scan(%v) = %s, want %s", tt.value, date, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateScanUnixOutOfRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+	}{
+		{name: "NaN", value: math.NaN()},
+		{name: "positive infinity", value: math.Inf(1)},
+		{name: "negative infinity", value: math.Inf(-1)},
+		{name: "too large", value: math.MaxInt64},
+		{name: "too small", value: -1e19},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := dbtypes.NewDateUTC(2015, time.October, 21)
+			if err := date.Scan(tt.value); !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+				t.Errorf("Scan(%v) error = %v, want %v", tt.value, err, dbtypes.ErrDateOutOfRange)
+			}
+		})
+	}
+}
+
+func TestDateComparisonsAcrossZones(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+	east := time.FixedZone("UTC+3", 3*3600)
+	west := time.FixedZone("UTC-5", -5*3600)
+
+	utc := func(y int, m time.Month, d int) dbtypes.Date {
+		return dbtypes.Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	}
+	in := func(loc *time.Location, y int, m time.Month, d int) dbtypes.Date {
+		return dbtypes.Date(time.Date(y, m, d, 0, 0, 0, 0, loc))
+	}
+
+	tests := []struct {
+		name   string
+		date   dbtypes.Date
+		other  dbtypes.Date
+		equal  bool
+		before bool
+		after  bool
+	}{
+		{
+			name:  "fixed zone east of UTC same day",
+			date:  in(east, 2015, time.October, 21),
+			other: utc(2015, time.October, 21),
+			equal: true,
+		},
+		{
+			name:  "fixed zone west of UTC same day",
+			date:  in(west, 2015, time.October, 21),
+			other: in(east, 2015, time.October, 21),
+			equal: true,
+		},
+		{
+			name:   "fixed zone west earlier day but later instant",
+			date:   dbtypes.Date(time.Date(2015, time.October, 20, 23, 0, 0, 0, west)),
+			other:  in(east, 2015, time.October, 21),
+			before: true,
+		},
+		{
+			// DST started at midnight on 2018-11-04, so local midnight does not exist
+			// and the first instant of the day is 01:00.
+			name:  "Sao Paulo spring forward day",
+			date:  dbtypes.Date(time.Date(2018, time.November, 4, 1, 0, 0, 0, saoPaulo)),
+			other: utc(2018, time.November, 4),
+			equal: true,
+		},
+		{
+			// 23:30 local is already 2018-11-04 in UTC.
+			name:   "Sao Paulo late evening before spring forward",
+			date:   dbtypes.Date(time.Date(2018, time.November, 3, 23, 30, 0, 0, saoPaulo)),
+			other:  utc(2018, time.November, 4),
+			before: true,
+		},
+		{
+			// DST ended at midnight on 2019-02-17, so local midnight occurs twice.
+			name:  "Sao Paulo fall back day",
+			date:  in(saoPaulo, 2019, time.February, 17),
+			other: utc(2019, time.February, 17),
+			equal: true,
+		},
+		{
+			name:  "Sao Paulo day after fall back",
+			date:  in(saoPaulo, 2019, time.February, 18),
+			other: in(east, 2019, time.February, 17),
+			after: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Equal(tt.other); got != tt.equal {
+				t.Errorf("Equal() = %v, want %v", got, tt.equal)
+			}
+			if got := tt.date.Before(tt.other); got != tt.before {
+				t.Errorf("Before() = %v, want %v", got, tt.before)
+			}
+			if got := tt.date.After(tt.other); got != tt.after {
+				t.Errorf("After() = %v, want %v", got, tt.after)
+			}
+		})
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		date1 dbtypes.Date
+		date2 dbtypes.Date
+		want  int
+	}{
+		{
+			name:  "Same day",
+			date1: dbtypes.NewDate(2021, time.January, 1),
+			date2: dbtypes.NewDate(2021, time.January, 1),
+			want:  0,
+		},
+		{
+			name:  "Later date",
+			date1: dbtypes.NewDate(2020, time.February, 28),
+			date2: dbtypes.NewDate(2020, time.March, 1),
+			want:  2,
+		},
+		{
+			name:  "Earlier date",
+			date1: dbtypes.NewDate(2021, time.January, 1),
+			date2: dbtypes.NewDate(2018, time.January, 1),
+			want:  -1096,
+		},
+		{
+			name:  "Earlier date spanning DST",
+			date1: dbtypes.Date(time.Date(2023, time.March, 13, 0, 0, 0, 0, newYork)),
+			date2: dbtypes.Date(time.Date(2023, time.March, 10, 0, 0, 0, 0, newYork)),
+			want:  -3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date1.DaysUntil(tt.date2); got != tt.want {
+				t.Errorf("DaysUntil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateString(t *testing.T) {
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		want string
+	}{
+		{name: "zero date", date: dbtypes.Date{}, want: ""},
+		{name: "year 1", date: dbtypes.NewDate(1, time.January, 2), want: "0001-01-02"},
+		{name: "year 33", date: dbtypes.NewDate(33, time.January, 1), want: "0033-01-01"},
+		{name: "year 999", date: dbtypes.NewDate(999, time.December, 31), want: "0999-12-31"},
+		{name: "year 2015", date: dbtypes.NewDate(2015, time.October, 21), want: "2015-10-21"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+
+			parsed, err := dbtypes.ParseDate(tt.date.String())
+			if tt.date.IsZero() {
+				if !errors.Is(err, dbtypes.ErrEmptyDate) {
+					t.Errorf("ParseDate(%q) error = %v, want ErrEmptyDate", tt.date.String(), err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) returned error: %v", tt.date.String(), err)
+			}
+			if !parsed.Equal(tt.date) {
+				t.Errorf("ParseDate(String()) = %v, want %v", parsed, tt.date)
+			}
+			if parsed.IsZero() != tt.date.IsZero() {
+				t.Errorf("ParseDate(String()).IsZero() = %v, want %v", parsed.IsZero(), tt.date.IsZero())
+			}
+		})
+	}
+}
+
+func TestNewDateLocations(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+	tokyo := time.FixedZone("JST", 9*3600)
+
+	dbtypes.SetDefaultLocation(tokyo)
+	defer dbtypes.SetDefaultLocation(nil)
+
+	local := dbtypes.NewDate(2015, time.October, 21)
+	if loc := time.Time(local).Location(); loc != tokyo {
+		t.Errorf("NewDate() location = %v, want %v", loc, tokyo)
+	}
+
+	dates := []dbtypes.Date{
+		local,
+		dbtypes.NewDateUTC(2015, time.October, 21),
+		dbtypes.NewDateIn(2015, time.October, 21, saoPaulo),
+		dbtypes.NewDateIn(2015, time.October, 21, time.FixedZone("UTC-11", -11*3600)),
+	}
+
+	for i, date := range dates {
+		for j, other := range dates {
+			if !date.Equal(other) {
+				t.Errorf("dates[%d] (%v) should equal dates[%d] (%v)", i, time.Time(date), j, time.Time(other))
+			}
+		}
+	}
+
+	var scanned dbtypes.Date
+	if err := scanned.Scan(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+	if !scanned.Equal(local) {
+		t.Errorf("Scanned UTC date %v should equal %v", scanned, local)
+	}
+
+	today := dbtypes.Today()
+	if loc := time.Time(today).Location(); loc != tokyo {
+		t.Errorf("Today() location = %v, want %v", loc, tokyo)
+	}
+	if want := time.Now().In(tokyo).Day(); today.Day() != want {
+		t.Errorf("Today().Day() = %d, want %d", today.Day(), want)
+	}
+}
+
+func TestNewDateInDSTGap(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
+	// Midnight did not exist on 2018-11-04 in Sao Paulo.
+	date := dbtypes.NewDateIn(2018, time.November, 4, saoPaulo)
+	if date.String() != "2018-11-04" {
+		t.Errorf("NewDateIn() = %s, want 2018-11-04", date)
+	}
+	if hour := time.Time(date).Hour(); hour != 1 {
+		t.Errorf("NewDateIn() hour = %d, want 1", hour)
+	}
+
+	// Out-of-range days are normalized like time.Date.
+	if got := dbtypes.NewDateUTC(2023, time.February, 30); got.String() != "2023-03-02" {
+		t.Errorf("NewDateUTC(2023, 2, 30) = %s, want 2023-03-02", got)
+	}
+}
+
+type stringerDate string
+
+func (s stringerDate) String() string {
+	return string(s)
+}
+
+func TestDateFormScan(t *testing.T) {
+	existing := dbtypes.NewDateUTC(2000, time.January, 1)
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "string", value: "2015-10-21", want: "2015-10-21"},
+		{name: "empty string", value: "", want: existing.String()},
+		{name: "bytes", value: []byte("2015-10-21"), want: "2015-10-21"},
+		{name: "string slice", value: []string{"2015-10-21", "2016-01-01"}, want: "2015-10-21"},
+		{name: "string slice with empty first element", value: []string{"", "2016-01-01"}, want: existing.String()},
+		{name: "empty string slice", value: []string{}, want: existing.String()},
+		{name: "time", value: time.Date(2015, 10, 21, 14, 30, 0, 0, time.UTC), want: "2015-10-21"},
+		{name: "stringer", value: stringerDate("2015-10-21"), want: "2015-10-21"},
+		{name: "invalid string", value: "21/10/2015", wantErr: true},
+		{name: "unsupported type", value: 20151021, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := existing
+			err := date.FormScan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FormScan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if date.String() != tt.want {
+				t.Errorf("FormScan(%v) = %s, want %s", tt.value, date, tt.want)
+			}
+			if tm := time.Time(date); tm.Hour() != 0 || tm.Minute() != 0 {
+				t.Errorf("FormScan(%v) kept a time of day: %v", tt.value, tm)
+			}
+		})
+	}
+}
+
+func TestDateUnmarshalTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "UTC midnight", data: `"2015-10-21T00:00:00Z"`, want: "2015-10-21"},
+		{name: "positive offset", data: `"2015-10-21T08:30:00+03:00"`, want: "2015-10-21"},
+		{name: "offset pushes UTC day backwards", data: `"2015-10-21T01:00:00+03:00"`, want: "2015-10-21"},
+		{name: "offset pushes UTC day forwards", data: `"2015-10-21T22:00:00-05:00"`, want: "2015-10-21"},
+		{name: "fractional seconds", data: `"2015-10-21T23:59:59.999Z"`, want: "2015-10-21"},
+		{name: "no zone", data: `"2015-10-21T08:30"`, want: "2015-10-21"},
+		{name: "invalid prefix", data: `"2015-13-21T08:30:00Z"`, wantErr: true},
+		{name: "garbage", data: `"yesterday"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var date dbtypes.Date
+			err := json.Unmarshal([]byte(tt.data), &date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.data, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if date.String() != tt.want {
+				t.Errorf("Unmarshal(%s) = %s, want %s", tt.data, date, tt.want)
+			}
+			if !date.Equal(dbtypes.NewDateUTC(2015, time.October, 21)) {
+				t.Errorf("Unmarshal(%s) = %v, want it to equal 2015-10-21", tt.data, time.Time(date))
+			}
+		})
+	}
+}
+
+func TestSetDateLayouts(t *testing.T) {
+	defer dbtypes.SetDateLayouts()
+
+	tests := []struct {
+		name    string
+		layouts []string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "default rejects dd/mm/yyyy", input: "21/10/2015", wantErr: true},
+		{name: "dd/mm/yyyy", layouts: []string{"02/01/2006"}, input: "21/10/2015", want: "2015-10-21"},
+		{name: "mm-dd-yyyy", layouts: []string{"01-02-2006"}, input: "10-21-2015", want: "2015-10-21"},
+		{name: "standard layout still first", layouts: []string{"02/01/2006"}, input: "2015-10-21", want: "2015-10-21"},
+		{name: "ambiguous resolved as dd/mm", layouts: []string{"02/01/2006", "01/02/2006"}, input: "02/03/2023", want: "2023-03-02"},
+		{name: "ambiguous resolved as mm/dd", layouts: []string{"01/02/2006", "02/01/2006"}, input: "02/03/2023", want: "2023-02-03"},
+		{name: "falls through to second layout", layouts: []string{"01/02/2006", "02/01/2006"}, input: "21/10/2015", want: "2015-10-21"},
+		{name: "no layout matches", layouts: []string{"02/01/2006"}, input: "2015.10.21", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtypes.SetDateLayouts(tt.layouts...)
+
+			parsed, err := dbtypes.ParseDate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDate(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+
+			var fromJSON dbtypes.Date
+			jsonErr := json.Unmarshal([]byte(`"`+tt.input+`"`), &fromJSON)
+			if (jsonErr != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON(%q) error = %v, wantErr %v", tt.input, jsonErr, tt.wantErr)
+			}
+
+			var fromForm dbtypes.Date
+			formErr := fromForm.FormScan(tt.input)
+			if (formErr != nil) != tt.wantErr {
+				t.Fatalf("FormScan(%q) error = %v, wantErr %v", tt.input, formErr, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			for _, got := range []dbtypes.Date{parsed, fromJSON, fromForm} {
+				if got.String() != tt.want {
+					t.Errorf("Parsed %q as %s, want %s", tt.input, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseDateErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{name: "empty", input: "", want: dbtypes.ErrEmptyDate},
+		{name: "whitespace", input: "   ", want: dbtypes.ErrEmptyDate},
+		{name: "bad format", input: "21/10/2015", want: dbtypes.ErrInvalidDateFormat},
+		{name: "trailing garbage", input: "2015-10-21x", want: dbtypes.ErrInvalidDateFormat},
+		{name: "day out of range", input: "2015-02-30", want: dbtypes.ErrDateOutOfRange},
+		{name: "month out of range", input: "2015-13-01", want: dbtypes.ErrDateOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := dbtypes.ParseDate(tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("ParseDate(%q) error = %v, want %v", tt.input, err, tt.want)
+			}
+
+			var parseErr *dbtypes.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseDate(%q) error %T is not a *ParseError", tt.input, err)
+			}
+			if parseErr.Input != tt.input || parseErr.Layout != dbtypes.DateLayout {
+				t.Errorf("ParseError = %+v, want Input %q and Layout %q", parseErr, tt.input, dbtypes.DateLayout)
+			}
+
+			// Empty input is a no-op for JSON and forms.
+			if tt.want == dbtypes.ErrEmptyDate {
+				return
+			}
+
+			var date dbtypes.Date
+			if err := date.UnmarshalJSON([]byte(`"` + tt.input + `"`)); !errors.Is(err, tt.want) {
+				t.Errorf("UnmarshalJSON(%q) error = %v, want %v", tt.input, err, tt.want)
+			}
+			if err := date.FormScan(tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("FormScan(%q) error = %v, want %v", tt.input, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateUnmarshalNonString(t *testing.T) {
+	var date dbtypes.Date
+	err := json.Unmarshal([]byte("20151021"), &date)
+
+	var parseErr *dbtypes.ParseError
+	if !errors.As(err, &parseErr) || !errors.Is(err, dbtypes.ErrInvalidDateFormat) {
+		t.Errorf("Unmarshal(20151021) error = %v, want *ParseError wrapping ErrInvalidDateFormat", err)
+	}
+}
+
+func TestDateText(t *testing.T) {
+	date := dbtypes.NewDate(2015, time.October, 21)
+
+	text, err := date.MarshalText()
+	if err != nil || string(text) != "2015-10-21" {
+		t.Errorf("MarshalText() = %q, %v; want 2015-10-21", text, err)
+	}
+
+	text, err = dbtypes.Date{}.MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Errorf("MarshalText() of zero date = %q, %v; want empty", text, err)
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{name: "date", text: "2015-10-21", want: "2015-10-21"},
+		{name: "timestamp", text: "2015-10-21T01:00:00+03:00", want: "2015-10-21"},
+		{name: "empty", text: "", want: ""},
+		{name: "quoted", text: `"2015-10-21"`, wantErr: true},
+		{name: "invalid", text: "21/10/2015", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dbtypes.NewDate(2000, time.January, 1)
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText(%q) error = %v, wantErr %v", tt.text, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("UnmarshalText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateMapKey(t *testing.T) {
+	counts := map[dbtypes.Date]int{
+		dbtypes.NewDateUTC(2015, time.October, 21):  3,
+		dbtypes.NewDateUTC(2016, time.February, 29): 5,
+	}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		t.Fatalf("Failed to marshal map: %v", err)
+	}
+	if want := `{"2015-10-21":3,"2016-02-29":5}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got map[dbtypes.Date]int
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Failed to unmarshal map: %v", err)
+	}
+	if len(got) != len(counts) {
+		t.Fatalf("Unmarshal() = %v, want %v", got, counts)
+	}
+	for date, count := range counts {
+		if got[date] != count {
+			t.Errorf("got[%s] = %d, want %d", date, got[date], count)
+		}
+	}
+}
+
+func TestDateXML(t *testing.T) {
+	type visit struct {
+		XMLName   xml.Name     `xml:"visit"`
+		Date      dbtypes.Date `xml:"date,attr"`
+		Followup  dbtypes.Date `xml:"followup"`
+		Discharge dbtypes.Date `xml:"discharge"`
+	}
+
+	v := visit{
+		Date:     dbtypes.NewDateUTC(2015, time.October, 21),
+		Followup: dbtypes.NewDateUTC(2015, time.November, 4),
+	}
+
+	data, err := xml.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to marshal XML: %v", err)
+	}
+
+	want := `<visit date="2015-10-21"><followup>2015-11-04</followup><discharge></discharge></visit>`
+	if string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got visit
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Failed to unmarshal XML: %v", err)
+	}
+	if !got.Date.Equal(v.Date) || !got.Followup.Equal(v.Followup) || !got.Discharge.IsZero() {
+		t.Errorf("XML round-trip = %+v, want %+v", got, v)
+	}
+}
+
+func TestDateBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		date dbtypes.Date
+	}{
+		{name: "zero date", date: dbtypes.Date{}},
+		{name: "modern date", date: dbtypes.NewDate(2015, time.October, 21)},
+		{name: "leap day", date: dbtypes.NewDate(2020, time.February, 29)},
+		{name: "year 1", date: dbtypes.NewDateUTC(1, time.January, 2)},
+		{name: "year 9999", date: dbtypes.NewDateUTC(9999, time.December, 31)},
+		{name: "large year", date: dbtypes.NewDateUTC(1000000, time.June, 15)},
+		{name: "negative year", date: dbtypes.NewDateUTC(-4713, time.November, 24)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.date.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() returned error: %v", err)
+			}
+			if len(data) > 8 {
+				t.Errorf("MarshalBinary() produced %d bytes, want at most 8", len(data))
+			}
+
+			var got dbtypes.Date
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() returned error: %v", err)
+			}
+			if !got.Equal(tt.date) || got.IsZero() != tt.date.IsZero() {
+				t.Errorf("Binary round-trip = %v, want %v", time.Time(got), time.Time(tt.date))
+			}
+
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tt.date); err != nil {
+				t.Fatalf("Failed to gob encode: %v", err)
+			}
+
+			var fromGob dbtypes.Date
+			if err := gob.NewDecoder(&buf).Decode(&fromGob); err != nil {
+				t.Fatalf("Failed to gob decode: %v", err)
+			}
+			if !fromGob.Equal(tt.date) || fromGob.IsZero() != tt.date.IsZero() {
+				t.Errorf("Gob round-trip = %v, want %v", time.Time(fromGob), time.Time(tt.date))
+			}
+		})
+	}
+}
+
+func TestDateBinaryLegacy(t *testing.T) {
+	// Previous versions gob-encoded dates using time.Time's encoding.
+	want := time.Date(2015, time.October, 21, 0, 0, 0, 0, time.FixedZone("EAT", 3*3600))
+	legacy, err := want.GobEncode()
+	if err != nil {
+		t.Fatalf("Failed to encode time: %v", err)
+	}
+
+	var date dbtypes.Date
+	if err := date.GobDecode(legacy); err != nil {
+		t.Fatalf("GobDecode() of legacy payload returned error: %v", err)
+	}
+	if !time.Time(date).Equal(want) {
+		t.Errorf("GobDecode() = %v, want %v", time.Time(date), want)
+	}
+}
+
+func TestDateBinaryDefaultLocation(t *testing.T) {
+	dbtypes.SetDefaultLocation(time.FixedZone("JST", 9*3600))
+	defer dbtypes.SetDefaultLocation(nil)
+
+	for _, date := range []dbtypes.Date{
+		dbtypes.NewDateUTC(2015, time.October, 21),
+		dbtypes.NewDate(2015, time.October, 21),
+		dbtypes.NewDateIn(2015, time.October, 21, time.FixedZone("UTC-11", -11*3600)),
+	} {
+		data, err := date.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() returned error: %v", err)
+		}
+
+		var got dbtypes.Date
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() returned error: %v", err)
+		}
+		if want := dbtypes.NewDateUTC(2015, time.October, 21); got != want {
+			t.Errorf("Binary round-trip of %v = %v, want %v", time.Time(date), time.Time(got), time.Time(want))
+		}
+
+		var fromJSON dbtypes.Date
+		if err := fromJSON.UnmarshalJSON([]byte(`"2015-10-21"`)); err != nil {
+			t.Fatalf("UnmarshalJSON() returned error: %v", err)
+		}
+		if got != fromJSON {
+			t.Errorf("UnmarshalBinary() = %v, UnmarshalJSON() = %v, want the same", time.Time(got), time.Time(fromJSON))
+		}
+	}
+}
+
+// binaryDate returns the MarshalBinary encoding of a date without validating it.
+func binaryDate(year int64, month, day byte) []byte {
+	return append(binary.AppendVarint([]byte{0x80}, year), month, day)
+}
+
+func TestDateUnmarshalBinaryInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{name: "empty", data: nil},
+		{name: "truncated", data: []byte{0x80, 0x02}},
+		{name: "invalid month", data: []byte{0x80, 0x02, 13, 1}},
+		{name: "invalid day", data: []byte{0x80, 0x02, 1, 0}},
+		{name: "trailing bytes", data: []byte{0x80, 0x02, 1, 1, 0}},
+		{name: "Feb 30", data: binaryDate(2024, 2, 30), wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "Feb 29 in non-leap year", data: binaryDate(2023, 2, 29), wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "Apr 31", data: binaryDate(2023, 4, 31), wantErr: dbtypes.ErrDateOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var date dbtypes.Date
+			err := date.UnmarshalBinary(tt.data)
+			if err == nil {
+				t.Fatalf("UnmarshalBinary(%v) = %v, want error", tt.data, date)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalBinary(%v) error = %v, want %v", tt.data, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDateCompare(t *testing.T) {
+	east := time.FixedZone("UTC+3", 3*3600)
+	west := time.FixedZone("UTC-5", -5*3600)
+
+	tests := []struct {
+		name  string
+		date  dbtypes.Date
+		other dbtypes.Date
+		want  int
+	}{
+		{
+			name:  "same day",
+			date:  dbtypes.NewDateUTC(2015, time.October, 21),
+			other: dbtypes.NewDateUTC(2015, time.October, 21),
+			want:  0,
+		},
+		{
+			name:  "same day different zones",
+			date:  dbtypes.NewDateIn(2015, time.October, 21, east),
+			other: dbtypes.NewDateIn(2015, time.October, 21, west),
+			want:  0,
+		},
+		{
+			name:  "earlier day but later instant",
+			date:  dbtypes.Date(time.Date(2015, time.October, 20, 23, 0, 0, 0, west)),
+			other: dbtypes.NewDateIn(2015, time.October, 21, east),
+			want:  -1,
+		},
+		{
+			name:  "later day",
+			date:  dbtypes.NewDateUTC(2016, time.January, 1),
+			other: dbtypes.NewDateUTC(2015, time.December, 31),
+			want:  1,
+		},
+		{
+			name:  "zero date",
+			date:  dbtypes.Date{},
+			other: dbtypes.NewDateUTC(2015, time.December, 31),
+			want:  -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Compare(tt.other); got != tt.want {
+				t.Errorf("Compare() = %d, want %d", got, tt.want)
+			}
+			if got := tt.other.Compare(tt.date); got != -tt.want {
+				t.Errorf("reversed Compare() = %d, want %d", got, -tt.want)
+			}
+			if (tt.want == 0) != tt.date.Equal(tt.other) {
+				t.Errorf("Compare() = %d inconsistent with Equal()", tt.want)
+			}
+		})
+	}
+}
+
+func TestDateCompareSortFunc(t *testing.T) {
+	east := time.FixedZone("UTC+3", 3*3600)
+	west := time.FixedZone("UTC-5", -5*3600)
+
+	dates := []dbtypes.Date{
+		dbtypes.NewDateIn(2015, time.October, 22, east),
+		dbtypes.NewDateUTC(2014, time.January, 1),
+		dbtypes.Date(time.Date(2015, time.October, 21, 23, 0, 0, 0, west)),
+		dbtypes.NewDateIn(2015, time.October, 20, west),
+	}
+
+	slices.SortFunc(dates, dbtypes.Date.Compare)
+
+	want := []string{"2014-01-01", "2015-10-20", "2015-10-21", "2015-10-22"}
+	for i, date := range dates {
+		if date.String() != want[i] {
+			t.Errorf("dates[%d] = %s, want %s", i, date, want[i])
+		}
+	}
+
+	i, found := slices.BinarySearchFunc(dates, dbtypes.NewDateUTC(2015, time.October, 21), dbtypes.Date.Compare)
+	if !found || i != 2 {
+		t.Errorf("BinarySearchFunc() = %d, %v; want 2, true", i, found)
+	}
+}
+
+func TestDateWeekday(t *testing.T) {
+	tests := []struct {
+		date dbtypes.Date
+		want time.Weekday
+	}{
+		{date: dbtypes.NewDate(2015, time.October, 21), want: time.Wednesday},
+		{date: dbtypes.NewDate(2000, time.January, 1), want: time.Saturday},
+		{date: dbtypes.NewDate(2023, time.October, 15), want: time.Sunday},
+		{date: dbtypes.NewDate(2024, time.February, 29), want: time.Thursday},
+		{date: dbtypes.NewDate(2023, time.October, 13), want: time.Friday},
+	}
+
+	for _, tt := range tests {
+		if got := tt.date.Weekday(); got != tt.want {
+			t.Errorf("%s.Weekday() = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestDateIsWeekend(t *testing.T) {
+	defer dbtypes.SetWeekend()
+
+	friday := dbtypes.NewDate(2023, time.October, 13)
+	saturday := dbtypes.NewDate(2023, time.October, 14)
+	sunday := dbtypes.NewDate(2023, time.October, 15)
+	monday := dbtypes.NewDate(2023, time.October, 16)
+
+	tests := []struct {
+		name    string
+		weekend []time.Weekday
+		want    map[dbtypes.Date]bool
+	}{
+		{
+			name: "default Saturday and Sunday",
+			want: map[dbtypes.Date]bool{friday: false, saturday: true, sunday: true, monday: false},
+		},
+		{
+			name:    "Friday and Saturday",
+			weekend: []time.Weekday{time.Friday, time.Saturday},
+			want:    map[dbtypes.Date]bool{friday: true, saturday: true, sunday: false, monday: false},
+		},
+		{
+			name:    "Sunday only",
+			weekend: []time.Weekday{time.Sunday},
+			want:    map[dbtypes.Date]bool{friday: false, saturday: false, sunday: true, monday: false},
+		},
+		{
+			name:    "out of range days",
+			weekend: []time.Weekday{time.Weekday(-1), time.Weekday(8)},
+			want:    map[dbtypes.Date]bool{friday: false, saturday: true, sunday: false, monday: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtypes.SetWeekend(tt.weekend...)
+			for date, want := range tt.want {
+				if got := date.IsWeekend(); got != want {
+					t.Errorf("%s (%v).IsWeekend() = %v, want %v", date, date.Weekday(), got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestYesterdayTomorrow(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
+	dbtypes.SetDefaultLocation(saoPaulo)
+	defer dbtypes.SetDefaultLocation(nil)
+
+	today := dbtypes.Today()
+	yesterday, tomorrow := dbtypes.Yesterday(), dbtypes.Tomorrow()
+
+	if today.DaysUntil(yesterday) != -1 || today.DaysUntil(tomorrow) != 1 {
+		t.Errorf("Yesterday() = %s, Today() = %s, Tomorrow() = %s", yesterday, today, tomorrow)
+	}
+	for _, date := range []dbtypes.Date{yesterday, tomorrow} {
+		if loc := time.Time(date).Location(); loc != saoPaulo {
+			t.Errorf("%s location = %v, want %v", date, loc, saoPaulo)
+		}
+	}
+}
+
+func TestDateNextPrev(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
+	dbtypes.SetDefaultLocation(saoPaulo)
+	defer dbtypes.SetDefaultLocation(nil)
+
+	tests := []struct {
+		name     string
+		date     dbtypes.Date
+		wantPrev string
+		wantNext string
+	}{
+		// DST started at midnight on 2018-11-04: that day lasted 23 hours.
+		{name: "before spring forward", date: dbtypes.NewDate(2018, time.November, 3), wantPrev: "2018-11-02", wantNext: "2018-11-04"},
+		{name: "spring forward day", date: dbtypes.NewDate(2018, time.November, 4), wantPrev: "2018-11-03", wantNext: "2018-11-05"},
+		{name: "after spring forward", date: dbtypes.NewDate(2018, time.November, 5), wantPrev: "2018-11-04", wantNext: "2018-11-06"},
+		// DST ended at midnight on 2019-02-17: the previous day lasted 25 hours.
+		{name: "fall back day", date: dbtypes.NewDate(2019, time.February, 17), wantPrev: "2019-02-16", wantNext: "2019-02-18"},
+		{name: "before fall back", date: dbtypes.NewDate(2019, time.February, 16), wantPrev: "2019-02-15", wantNext: "2019-02-17"},
+		{name: "year boundary", date: dbtypes.NewDate(2018, time.December, 31), wantPrev: "2018-12-30", wantNext: "2019-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Prev(); got.String() != tt.wantPrev {
+				t.Errorf("Prev() = %s, want %s", got, tt.wantPrev)
+			}
+			if got := tt.date.Next(); got.String() != tt.wantNext {
+				t.Errorf("Next() = %s, want %s", got, tt.wantNext)
+			}
+			if got := tt.date.Next().Prev(); !got.Equal(tt.date) {
+				t.Errorf("Next().Prev() = %s, want %s", got, tt.date)
+			}
+		})
+	}
+}
+
+func TestDateFromTime(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*3600)
+	ts := time.Date(2015, time.October, 21, 14, 30, 15, 500, eat)
+
+	raw := dbtypes.Date(ts)
+	date := dbtypes.DateFromTime(ts)
+
+	if got := time.Time(date); got.Hour() != 0 || got.Minute() != 0 || got.Second() != 0 || got.Nanosecond() != 0 {
+		t.Errorf("DateFromTime() kept a time of day: %v", got)
+	}
+	if loc := time.Time(date).Location(); loc != eat {
+		t.Errorf("DateFromTime() location = %v, want %v", loc, eat)
+	}
+	if date.String() != "2015-10-21" || !date.Equal(raw) {
+		t.Errorf("DateFromTime() = %s, want 2015-10-21", date)
+	}
+
+	// The raw conversion keeps the time of day in its binary form.
+	if time.Time(raw).Equal(time.Time(date)) {
+		t.Errorf("Date(t) unexpectedly equals DateFromTime(t) as a time.Time")
+	}
+
+	canonical := dbtypes.NewDateIn(2015, time.October, 21, eat)
+	if !time.Time(date).Equal(time.Time(canonical)) {
+		t.Errorf("DateFromTime() = %v, want %v", time.Time(date), time.Time(canonical))
+	}
+
+	value, err := date.Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if !value.(time.Time).Equal(time.Time(canonical)) {
+		t.Errorf("Value() = %v, want %v", value, time.Time(canonical))
+	}
+
+	rawGob, _ := raw.GobEncode()
+	dateGob, _ := date.GobEncode()
+	if !bytes.Equal(rawGob, dateGob) {
+		t.Errorf("GobEncode() differs between Date(t) and DateFromTime(t)")
+	}
+}
+
+func TestDateUnix(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*3600)
+
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		want int64
+	}{
+		{name: "UTC midnight", date: dbtypes.NewDateUTC(2015, time.October, 21), want: 1445385600},
+		{name: "east of UTC", date: dbtypes.NewDateIn(2015, time.October, 21, eat), want: 1445385600 - 3*3600},
+		{name: "time of day ignored", date: dbtypes.Date(time.Date(2015, time.October, 21, 18, 0, 0, 0, time.UTC)), want: 1445385600},
+		{name: "epoch", date: dbtypes.NewDateUTC(1970, time.January, 1), want: 0},
+		{name: "before epoch", date: dbtypes.NewDateUTC(1969, time.December, 31), want: -86400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Unix(); got != tt.want {
+				t.Errorf("Unix() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDateLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		layout  string
+		want    string
+		wantErr error
+	}{
+		{name: "day month year", value: "21 Oct 2015", layout: "02 Jan 2006", want: "2015-10-21"},
+		{name: "slashes", value: "2015/10/21", layout: "2006/01/02", want: "2015-10-21"},
+		{name: "time discarded", value: "2015-10-21 23:59:59", layout: "2006-01-02 15:04:05", want: "2015-10-21"},
+		{name: "offset ignored", value: "2015-10-21T23:30:00-05:00", layout: time.RFC3339, want: "2015-10-21"},
+		{name: "day of year", value: "2024.060", layout: "2006.002", want: "2024-02-29"},
+		{name: "no day", value: "2015-10", layout: "2006-01", wantErr: dbtypes.ErrLayoutNoDay},
+		{name: "weekday only", value: "Wed 2015-10", layout: "Mon 2006-01", wantErr: dbtypes.ErrLayoutNoDay},
+		{name: "empty", value: "", layout: "2006/01/02", wantErr: dbtypes.ErrEmptyDate},
+		{name: "mismatch", value: "21 Oct 2015", layout: "2006/01/02", wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "out of range", value: "2015/02/30", layout: "2006/01/02", wantErr: dbtypes.ErrDateOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dbtypes.ParseDateLayout(tt.value, tt.layout)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseDateLayout(%q, %q) error = %v, want %v", tt.value, tt.layout, err, tt.wantErr)
+				}
+
+				var parseErr *dbtypes.ParseError
+				if !errors.As(err, &parseErr) || parseErr.Layout != tt.layout {
+					t.Errorf("ParseDateLayout() error = %#v, want *ParseError with Layout %q", err, tt.layout)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDateLayout(%q, %q) returned error: %v", tt.value, tt.layout, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseDateLayout(%q, %q) = %s, want %s", tt.value, tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDateAny(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		layouts    []string
+		want       string
+		wantLayout string
+		wantErr    error
+	}{
+		{name: "iso", value: "2023-03-02", want: "2023-03-02", wantLayout: "2006-01-02"},
+		{name: "rfc3339", value: "2023-03-02T23:15:00-05:00", want: "2023-03-02", wantLayout: time.RFC3339},
+		{name: "ambiguous is dd/mm", value: "02/03/2023", want: "2023-03-02", wantLayout: "02/01/2006"},
+		{name: "day first above 12", value: "13/02/2023", want: "2023-02-13", wantLayout: "02/01/2006"},
+		{name: "month first when day above 12", value: "02/13/2023", want: "2023-02-13", wantLayout: "01/02/2006"},
+		{name: "month name", value: "Mar 2, 2023", want: "2023-03-02", wantLayout: "Jan 2, 2006"},
+		{name: "compact", value: "20230302", want: "2023-03-02", wantLayout: "20060102"},
+		{
+			name:       "custom layouts",
+			value:      "02/03/2023",
+			layouts:    []string{"01/02/2006", "02/01/2006"},
+			want:       "2023-02-03",
+			wantLayout: "01/02/2006",
+		},
+		{name: "empty", value: " ", wantErr: dbtypes.ErrEmptyDate},
+		{name: "garbage", value: "not a date", wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "both components above 12", value: "13/13/2023", wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "iso out of range", value: "2023-02-30", wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "custom layouts no match", value: "2023-03-02", layouts: []string{"02/01/2006"}, wantErr: dbtypes.ErrInvalidDateFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, layout, err := dbtypes.ParseDateAny(tt.value, tt.layouts...)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseDateAny(%q) error = %v, want %v", tt.value, err, tt.wantErr)
+				}
+				var parseErr *dbtypes.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Errorf("ParseDateAny(%q) error %T is not a *ParseError", tt.value, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDateAny(%q) returned error: %v", tt.value, err)
+			}
+			if got.String() != tt.want || layout != tt.wantLayout {
+				t.Errorf("ParseDateAny(%q) = %s, %q, want %s, %q", tt.value, got, layout, tt.want, tt.wantLayout)
+			}
+		})
+	}
+}
+
+func TestSetTwoDigitYearPivot(t *testing.T) {
+	defer dbtypes.SetTwoDigitYearPivot(69)
+	defer dbtypes.SetDateLayouts()
+	dbtypes.SetDateLayouts("02/01/06")
+
+	tests := []struct {
+		name    string
+		pivot   int
+		value   string
+		want    string
+		wantErr error
+	}{
+		{name: "default below pivot", pivot: 69, value: "21/10/68", want: "2068-10-21"},
+		{name: "default at pivot", pivot: 69, value: "21/10/69", want: "1969-10-21"},
+		{name: "default recent", pivot: 69, value: "21/10/15", want: "2015-10-21"},
+		{name: "custom below pivot", pivot: 50, value: "21/10/49", want: "2049-10-21"},
+		{name: "custom at pivot", pivot: 50, value: "21/10/50", want: "1950-10-21"},
+		{name: "custom above default", pivot: 80, value: "21/10/75", want: "2075-10-21"},
+		{name: "all 2000s", pivot: 100, value: "21/10/99", want: "2099-10-21"},
+		{name: "all 1900s", pivot: 0, value: "21/10/00", want: "1900-10-21"},
+		{name: "leap day in 2000", pivot: 69, value: "29/02/00", want: "2000-02-29"},
+		{name: "no leap day in 1900", pivot: 0, value: "29/02/00", wantErr: dbtypes.ErrDateOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtypes.SetTwoDigitYearPivot(tt.pivot)
+
+			got, err := dbtypes.ParseDateLayout(tt.value, "02/01/06")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseDateLayout(%q) error = %v, want %v", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDateLayout(%q) returned error: %v", tt.value, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseDateLayout(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+
+			if got, _, err := dbtypes.ParseDateAny(tt.value, "02/01/06"); err != nil || got.String() != tt.want {
+				t.Errorf("ParseDateAny(%q) = %s, %v, want %s", tt.value, got, err, tt.want)
+			}
+
+			strftimeValue := strings.ReplaceAll(tt.value, "/", "-")
+			if got, err := dbtypes.ParseStrftime("%d-%m-%y", strftimeValue); err != nil || got.String() != tt.want {
+				t.Errorf("ParseStrftime(%q) = %s, %v, want %s", strftimeValue, got, err, tt.want)
+			}
+
+			var date dbtypes.Date
+			if err := date.UnmarshalJSON([]byte(`"` + tt.value + `"`)); err != nil || date.String() != tt.want {
+				t.Errorf("UnmarshalJSON(%q) = %s, %v, want %s", tt.value, date, err, tt.want)
+			}
+			if err := date.FormScan(tt.value); err != nil || date.String() != tt.want {
+				t.Errorf("FormScan(%q) = %s, %v, want %s", tt.value, date, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTwoDigitYearPivotPanics(t *testing.T) {
+	for _, pivot := range []int{-1, 101} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetTwoDigitYearPivot(%d) did not panic", pivot)
+				}
+			}()
+			dbtypes.SetTwoDigitYearPivot(pivot)
+		}()
+	}
+}
+
+func TestFourDigitYearLayoutIgnoresPivot(t *testing.T) {
+	defer dbtypes.SetTwoDigitYearPivot(69)
+	dbtypes.SetTwoDigitYearPivot(100)
+
+	for _, layout := range []string{"02/01/2006", "20060102", "2006.002", "02 Jan 2006 15:04:05 -0700"} {
+		want := dbtypes.NewDateUTC(1975, time.October, 21)
+		got, err := dbtypes.ParseDateLayout(want.Format(layout), layout)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseDateLayout(%q) = %s, %v, want %s", layout, got, err, want)
+		}
+	}
+}
+
+func TestDateYAML(t *testing.T) {
+	type fixture struct {
+		Name string       `yaml:"name"`
+		Born dbtypes.Date `yaml:"born"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    dbtypes.Date
+		wantErr bool
+	}{
+		{name: "unquoted", input: "born: 2015-10-21", want: dbtypes.NewDateUTC(2015, time.October, 21)},
+		{name: "double quoted", input: `born: "2015-10-21"`, want: dbtypes.NewDateUTC(2015, time.October, 21)},
+		{name: "single quoted", input: "born: '2015-10-21'", want: dbtypes.NewDateUTC(2015, time.October, 21)},
+		{name: "rfc3339 timestamp", input: "born: 2015-10-21T23:30:00-05:00", want: dbtypes.NewDateUTC(2015, time.October, 21)},
+		{name: "yaml timestamp", input: "born: 2015-10-21 23:30:00.10", want: dbtypes.NewDateUTC(2015, time.October, 21)},
+		{name: "explicit tag", input: "born: !!timestamp 2015-10-21", want: dbtypes.NewDateUTC(2015, time.October, 21)},
+		{name: "null", input: "born: null", want: dbtypes.Date{}},
+		{name: "empty string", input: `born: ""`, want: dbtypes.Date{}},
+		{name: "missing", input: "name: marty", want: dbtypes.Date{}},
+		{name: "invalid", input: "born: 21/10/2015", wantErr: true},
+		{name: "number", input: "born: 20151021", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got fixture
+			err := yaml.Unmarshal([]byte(tt.input), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("yaml.Unmarshal(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err == nil && !got.Born.Equal(tt.want) {
+				t.Errorf("yaml.Unmarshal(%q) = %s, want %s", tt.input, got.Born, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateYAMLRoundTrip(t *testing.T) {
+	type fixture struct {
+		Born dbtypes.Date `yaml:"born"`
+	}
+
+	for _, date := range []dbtypes.Date{
+		dbtypes.NewDateUTC(2015, time.October, 21),
+		dbtypes.NewDateUTC(1, time.January, 2),
+		{},
+	} {
+		data, err := yaml.Marshal(fixture{Born: date})
+		if err != nil {
+			t.Fatalf("yaml.Marshal(%s) returned error: %v", date, err)
+		}
+
+		want := "born: " + date.String() + "\n"
+		if date.IsZero() {
+			want = "born: null\n"
+		}
+		if string(data) != want && string(data) != `born: "`+date.String()+"\"\n" {
+			t.Errorf("yaml.Marshal(%s) = %q, want %q", date, data, want)
+		}
+
+		var got fixture
+		if err := yaml.Unmarshal(data, &got); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q) returned error: %v", data, err)
+		}
+		if !got.Born.Equal(date) {
+			t.Errorf("YAML round trip of %s = %s", date, got.Born)
+		}
+	}
+}
+
+func TestDateAt(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		date    dbtypes.Date
+		h, m, s int
+		loc     *time.Location
+		want    string
+	}{
+		{
+			name: "afternoon", date: dbtypes.NewDateUTC(2023, time.October, 21), h: 14, m: 30, loc: newYork,
+			want: "2023-10-21T14:30:00-04:00",
+		},
+		{
+			name: "nil location uses the date's", date: dbtypes.NewDateUTC(2023, time.October, 21), h: 9, loc: nil,
+			want: "2023-10-21T09:00:00Z",
+		},
+		{
+			name: "overflow normalized", date: dbtypes.NewDateUTC(2023, time.October, 21), h: 24, m: 30, loc: time.UTC,
+			want: "2023-10-22T00:30:00Z",
+		},
+		{
+			name: "spring forward gap", date: dbtypes.NewDateUTC(2023, time.March, 12), h: 2, m: 30, loc: newYork,
+			want: "2023-03-12T01:30:00-05:00",
+		},
+		{
+			name: "fall back repeated hour", date: dbtypes.NewDateUTC(2023, time.November, 5), h: 1, m: 30, loc: newYork,
+			want: "2023-11-05T01:30:00-04:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.date.At(tt.h, tt.m, tt.s, tt.loc)
+			if got.Format(time.RFC3339) != tt.want {
+				t.Errorf("At(%d, %d, %d) = %s, want %s", tt.h, tt.m, tt.s, got.Format(time.RFC3339), tt.want)
+			}
+			if tt.loc != nil && got.Location() != tt.loc {
+				t.Errorf("At().Location() = %v, want %v", got.Location(), tt.loc)
+			}
+		})
+	}
+}
+
+func TestDateStartEndOfDayIn(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		date      dbtypes.Date
+		loc       *time.Location
+		wantStart string
+		wantEnd   string
+		wantHours float64
+	}{
+		{
+			name: "regular day", date: dbtypes.NewDateUTC(2023, time.October, 21), loc: newYork,
+			wantStart: "2023-10-21T00:00:00-04:00", wantEnd: "2023-10-21T23:59:59.999999999-04:00", wantHours: 24,
+		},
+		{
+			name: "spring forward", date: dbtypes.NewDateUTC(2023, time.March, 12), loc: newYork,
+			wantStart: "2023-03-12T00:00:00-05:00", wantEnd: "2023-03-12T23:59:59.999999999-04:00", wantHours: 23,
+		},
+		{
+			name: "fall back", date: dbtypes.NewDateUTC(2023, time.November, 5), loc: newYork,
+			wantStart: "2023-11-05T00:00:00-04:00", wantEnd: "2023-11-05T23:59:59.999999999-05:00", wantHours: 25,
+		},
+		{
+			name: "midnight gap", date: dbtypes.NewDateUTC(2018, time.November, 4), loc: saoPaulo,
+			wantStart: "2018-11-04T01:00:00-02:00", wantEnd: "2018-11-04T23:59:59.999999999-02:00", wantHours: 23,
+		},
+		{
+			name: "day before midnight gap", date: dbtypes.NewDateUTC(2018, time.November, 3), loc: saoPaulo,
+			wantStart: "2018-11-03T00:00:00-03:00", wantEnd: "2018-11-03T23:59:59.999999999-03:00", wantHours: 24,
+		},
+		{
+			name: "nil location", date: dbtypes.NewDateUTC(2023, time.October, 21), loc: nil,
+			wantStart: "2023-10-21T00:00:00Z", wantEnd: "2023-10-21T23:59:59.999999999Z", wantHours: 24,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.date.StartOfDayIn(tt.loc), tt.date.EndOfDayIn(tt.loc)
+			if got := start.Format(time.RFC3339Nano); got != tt.wantStart {
+				t.Errorf("StartOfDayIn() = %s, want %s", got, tt.wantStart)
+			}
+			if got := end.Format(time.RFC3339Nano); got != tt.wantEnd {
+				t.Errorf("EndOfDayIn() = %s, want %s", got, tt.wantEnd)
+			}
+			if got := end.Add(time.Nanosecond).Sub(start).Hours(); got != tt.wantHours {
+				t.Errorf("day length = %v hours, want %v", got, tt.wantHours)
+			}
+		})
+	}
+}
+
+func TestDateUnmarshalJSONHostile(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "day only", data: `"21"`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "trailing junk", data: `"2015-10-21junk"`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "trailing junk after T", data: `"2015-10-21Tjunk"`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "trailing junk after time", data: `"2015-10-21T08:30junk"`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "NUL byte", data: `"2015-10-21\u0000"`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "embedded NUL", data: `"2015\u0000-10-21"`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "long string", data: `"` + strings.Repeat("2015-10-21", 100) + `"`, wantErr: dbtypes.ErrDateTooLong},
+		{name: "long escaped string", data: `"` + strings.Repeat(`\u0030`, 100) + `"`, wantErr: dbtypes.ErrDateTooLong},
+		{name: "escaped string too long once decoded", data: `"` + strings.Repeat(`\u0030`, 63) + `00"`, wantErr: dbtypes.ErrDateTooLong},
+		{name: "huge payload", data: `"` + strings.Repeat("x", 1<<20) + `"`, wantErr: dbtypes.ErrDateTooLong},
+		{name: "number", data: `20151021`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "unterminated", data: `"2015-10-21`, wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "extended year bad month", data: `"10000-13-21"`, wantErr: dbtypes.ErrInvalidDateFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := dbtypes.NewDateUTC(2000, time.January, 1)
+			err := date.UnmarshalJSON([]byte(tt.data))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UnmarshalJSON() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(err.Error()) > 256 {
+				t.Errorf("UnmarshalJSON() error message is %d bytes long", len(err.Error()))
+			}
+			if !date.Equal(dbtypes.NewDateUTC(2000, time.January, 1)) {
+				t.Errorf("UnmarshalJSON() modified the receiver: %s", date)
+			}
+		})
+	}
+}
+
+func TestDateJSONExtendedYearsRoundTrip(t *testing.T) {
+	dates := []dbtypes.Date{
+		dbtypes.NewDateUTC(10000, time.October, 21),
+		dbtypes.NewDateUTC(-44, time.March, 15),
+		dbtypes.NewDateUTC(0, time.February, 29),
+		dbtypes.NewDateUTC(-4713, time.November, 24),
+		dbtypes.NewDateUTC(123456789, time.December, 31),
+	}
+
+	for _, date := range dates {
+		data, err := json.Marshal(date)
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) returned error: %v", time.Time(date), err)
+		}
+
+		var got dbtypes.Date
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned error: %v", data, err)
+		}
+		if !got.Equal(date) {
+			t.Errorf("json round trip of %s = %v, want %v", data, time.Time(got), time.Time(date))
+		}
+	}
+}
+
+func FuzzDateJSON(f *testing.F) {
+	for _, seed := range []string{
+		`"2015-10-21"`, `null`, `""`, `"21"`, `"2015-10-21junk"`, `"2015-10-21T08:30"`,
+		`"2015-10-21T08:30:00+03:00"`, `"10000-10-21"`, `"-0044-03-15"`, `"2015-02-30"`,
+		`"2015-10-21\u0000"`, `"0000-01-01"`, `"0001-01-01"`, `{}`, `[`, `"\ud800"`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var date dbtypes.Date
+		if err := date.UnmarshalJSON(data); err != nil {
+			return
+		}
+
+		encoded, err := date.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON() of %v from %q returned error: %v", time.Time(date), data, err)
+		}
+
+		var got dbtypes.Date
+		if err := got.UnmarshalJSON(encoded); err != nil {
+			t.Fatalf("UnmarshalJSON(%s) from %q returned error: %v", encoded, data, err)
+		}
+		if !got.Equal(date) || got.IsZero() != date.IsZero() {
+			t.Fatalf("round trip of %q = %s, want %s", data, got, date)
+		}
+
+		reencoded, err := got.MarshalJSON()
+		if err != nil || !bytes.Equal(reencoded, encoded) {
+			t.Fatalf("MarshalJSON() = %s, %v, want %s", reencoded, err, encoded)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package dbtypes
+
+import "time"
+
+// DateRange is an inclusive range of calendar dates.
+// A zero Start or End means the range is unbounded on that side.
+type DateRange struct {
+	Start Date
+	End   Date
+}
+
+// NewDateRange returns the range from start to end inclusive.
+func NewDateRange(start, end Date) DateRange {
+	return DateRange{Start: start, End: end}
+}
+
+// IsValid reports whether both Start and End are set and Start is not after End.
+// Open-ended ranges are not valid, even though the other methods accept them.
+func (r DateRange) IsValid() bool {
+	return !r.Start.IsZero() && !r.End.IsZero() && !r.End.Before(r.Start)
+}
+
+// Contains reports whether date falls within the range, inclusive of both ends.
+// A zero date is never contained.
+func (r DateRange) Contains(date Date) bool {
+	if date.IsZero() {
+		return false
+	}
+	return (r.Start.IsZero() || !date.Before(r.Start)) && (r.End.IsZero() || !date.After(r.End))
+}
+
+// Overlaps reports whether r and other share at least one day.
+// Ranges that touch at an endpoint overlap.
+func (r DateRange) Overlaps(other DateRange) bool {
+	_, ok := r.Intersect(other)
+	return ok
+}
+
+// Days returns the number of days in the range, counting both ends.
+// It returns 0 for unbounded ranges and ranges whose End is before Start.
+func (r DateRange) Days() int {
+	if !r.IsValid() {
+		return 0
+	}
+	return int(r.End.civilDays()-r.Start.civilDays()) + 1
+}
+
+// Intersect returns the days common to r and other.
+// It reports false, with a zero DateRange, if the ranges do not overlap.
+// The result is unbounded on a side only if both ranges are.
+func (r DateRange) Intersect(other DateRange) (DateRange, bool) {
+	start := r.Start
+	if start.IsZero() || (!other.Start.IsZero() && other.Start.After(start)) {
+		start = other.Start
+	}
+
+	end := r.End
+	if end.IsZero() || (!other.End.IsZero() && other.End.Before(end)) {
+		end = other.End
+	}
+
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return DateRange{}, false
+	}
+	return DateRange{Start: start, End: end}, true
+}
+
+// OverlapDays returns the number of days r and other have in common,
+// counting both ends. It returns 0 if the ranges do not overlap and,
+// like Days, if the overlap is unbounded.
+func (r DateRange) OverlapDays(other DateRange) int {
+	in, ok := r.Intersect(other)
+	if !ok {
+		return 0
+	}
+	return in.Days()
+}
+
+// Union returns the days covered by r or other, both of which should have
+// Start on or before End. Overlapping ranges are merged into a single range;
+// otherwise both ranges are returned, earliest first. See UnionAdjacent to
+// also merge adjacent ranges.
+func (r DateRange) Union(other DateRange) []DateRange {
+	return r.union(other, false)
+}
+
+// UnionAdjacent is like Union but also merges ranges that do not overlap
+// but are adjacent, i.e. one ends the day before the other starts.
+func (r DateRange) UnionAdjacent(other DateRange) []DateRange {
+	return r.union(other, true)
+}
+
+func (r DateRange) union(other DateRange, mergeAdjacent bool) []DateRange {
+	first, second := r, other
+	if !r.Start.IsZero() && (other.Start.IsZero() || other.Start.Before(r.Start)) {
+		first, second = other, r
+	}
+
+	if !first.Overlaps(second) && !(mergeAdjacent && first.adjacentTo(second)) {
+		return []DateRange{first, second}
+	}
+
+	merged := DateRange{Start: first.Start, End: first.End}
+	if !merged.End.IsZero() && (second.End.IsZero() || second.End.After(merged.End)) {
+		merged.End = second.End
+	}
+	return []DateRange{merged}
+}
+
+// adjacentTo reports whether next starts the day after r ends.
+func (r DateRange) adjacentTo(next DateRange) bool {
+	return !r.End.IsZero() && !next.Start.IsZero() && next.Start.civilDays() == r.End.civilDays()+1
+}
+
+// SplitByMonth splits the range into calendar months. The first and last
+// pieces may be partial months. It returns nil if the range is not valid.
+func (r DateRange) SplitByMonth() []DateRange {
+	return r.split(Date.EndOfMonth)
+}
+
+// SplitByWeek splits the range into weeks starting on firstDay. The first and
+// last pieces may be partial weeks. It returns nil if the range is not valid.
+func (r DateRange) SplitByWeek(firstDay time.Weekday) []DateRange {
+	return r.split(func(date Date) Date {
+		return date.EndOfWeek(firstDay)
+	})
+}
+
+// SplitChunks splits the range into consecutive pieces of days days,
+// the last of which may be shorter. It returns nil if the range is not valid
+// or days is not positive.
+func (r DateRange) SplitChunks(days int) []DateRange {
+	if days <= 0 {
+		return nil
+	}
+	return r.split(func(date Date) Date {
+		return date.AddDays(days - 1)
+	})
+}
+
+// split returns contiguous pieces covering r, where pieceEnd returns
+// the last day of the piece starting on a given date.
+func (r DateRange) split(pieceEnd func(Date) Date) []DateRange {
+	if !r.IsValid() {
+		return nil
+	}
+
+	var pieces []DateRange
+	for start := r.Start; !start.After(r.End); {
+		end := pieceEnd(start)
+		if end.After(r.End) {
+			end = r.End
+		}
+		pieces = append(pieces, DateRange{Start: start, End: end})
+		start = end.AddDays(1)
+	}
+	return pieces
+}
+
+// String returns the range as "yyyy-mm-dd..yyyy-mm-dd".
+// An unbounded side is left empty, e.g. "2023-01-01..".
+func (r DateRange) String() string {
+	return r.Start.String() + ".." + r.End.String()
+}
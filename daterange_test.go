@@ -0,0 +1,446 @@
+package dbtypes_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// dateRange builds a DateRange from yyyy-mm-dd strings, "" meaning unbounded.
+func dateRange(start, end string) dbtypes.DateRange {
+	var r dbtypes.DateRange
+	if start != "" {
+		r.Start = dbtypes.MustParseDate(start)
+	}
+	if end != "" {
+		r.End = dbtypes.MustParseDate(end)
+	}
+	return r
+}
+
+func TestDateRangeIsValidAndDays(t *testing.T) {
+	tests := []struct {
+		r         dbtypes.DateRange
+		wantValid bool
+		wantDays  int
+	}{
+		{r: dateRange("2023-01-01", "2023-01-31"), wantValid: true, wantDays: 31},
+		{r: dateRange("2024-02-01", "2024-03-01"), wantValid: true, wantDays: 30},
+		{r: dateRange("2023-01-01", "2023-01-01"), wantValid: true, wantDays: 1},
+		{r: dateRange("2023-01-02", "2023-01-01"), wantValid: false, wantDays: 0},
+		{r: dateRange("", "2023-01-01"), wantValid: false, wantDays: 0},
+		{r: dateRange("2023-01-01", ""), wantValid: false, wantDays: 0},
+		{r: dateRange("", ""), wantValid: false, wantDays: 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.IsValid(); got != tt.wantValid {
+			t.Errorf("%s.IsValid() = %v, want %v", tt.r, got, tt.wantValid)
+		}
+		if got := tt.r.Days(); got != tt.wantDays {
+			t.Errorf("%s.Days() = %d, want %d", tt.r, got, tt.wantDays)
+		}
+	}
+}
+
+func TestDateRangeContains(t *testing.T) {
+	tests := []struct {
+		r    dbtypes.DateRange
+		date string
+		want bool
+	}{
+		{r: dateRange("2023-01-10", "2023-01-20"), date: "2023-01-15", want: true},
+		{r: dateRange("2023-01-10", "2023-01-20"), date: "2023-01-10", want: true},
+		{r: dateRange("2023-01-10", "2023-01-20"), date: "2023-01-20", want: true},
+		{r: dateRange("2023-01-10", "2023-01-20"), date: "2023-01-09", want: false},
+		{r: dateRange("2023-01-10", "2023-01-20"), date: "2023-01-21", want: false},
+		{r: dateRange("", "2023-01-20"), date: "1900-01-01", want: true},
+		{r: dateRange("2023-01-10", ""), date: "2999-12-31", want: true},
+		{r: dateRange("2023-01-10", ""), date: "2023-01-09", want: false},
+		{r: dateRange("", ""), date: "2023-01-09", want: true},
+		{r: dateRange("2023-01-20", "2023-01-10"), date: "2023-01-15", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.Contains(dbtypes.MustParseDate(tt.date)); got != tt.want {
+			t.Errorf("%s.Contains(%s) = %v, want %v", tt.r, tt.date, got, tt.want)
+		}
+	}
+
+	if dateRange("", "").Contains(dbtypes.Date{}) {
+		t.Errorf("Contains(zero date) = true, want false")
+	}
+}
+
+func TestDateRangeOverlapsAndIntersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   dbtypes.DateRange
+		want   bool
+		wantIn dbtypes.DateRange
+	}{
+		{
+			name:   "partial overlap",
+			a:      dateRange("2023-01-01", "2023-01-15"),
+			b:      dateRange("2023-01-10", "2023-01-31"),
+			want:   true,
+			wantIn: dateRange("2023-01-10", "2023-01-15"),
+		},
+		{
+			name:   "touching endpoints",
+			a:      dateRange("2023-01-01", "2023-01-10"),
+			b:      dateRange("2023-01-10", "2023-01-20"),
+			want:   true,
+			wantIn: dateRange("2023-01-10", "2023-01-10"),
+		},
+		{
+			name: "adjacent",
+			a:    dateRange("2023-01-01", "2023-01-09"),
+			b:    dateRange("2023-01-10", "2023-01-20"),
+			want: false,
+		},
+		{
+			name: "disjoint",
+			a:    dateRange("2023-01-01", "2023-01-05"),
+			b:    dateRange("2023-02-01", "2023-02-05"),
+			want: false,
+		},
+		{
+			name:   "containment",
+			a:      dateRange("2023-01-01", "2023-12-31"),
+			b:      dateRange("2023-03-01", "2023-03-31"),
+			want:   true,
+			wantIn: dateRange("2023-03-01", "2023-03-31"),
+		},
+		{
+			name:   "identical",
+			a:      dateRange("2023-03-01", "2023-03-31"),
+			b:      dateRange("2023-03-01", "2023-03-31"),
+			want:   true,
+			wantIn: dateRange("2023-03-01", "2023-03-31"),
+		},
+		{
+			name:   "single day inside",
+			a:      dateRange("2023-03-15", "2023-03-15"),
+			b:      dateRange("2023-03-01", "2023-03-31"),
+			want:   true,
+			wantIn: dateRange("2023-03-15", "2023-03-15"),
+		},
+		{
+			name:   "unbounded start",
+			a:      dateRange("", "2023-01-15"),
+			b:      dateRange("2023-01-10", "2023-01-31"),
+			want:   true,
+			wantIn: dateRange("2023-01-10", "2023-01-15"),
+		},
+		{
+			name:   "unbounded end",
+			a:      dateRange("2023-01-20", ""),
+			b:      dateRange("2023-01-10", "2023-01-31"),
+			want:   true,
+			wantIn: dateRange("2023-01-20", "2023-01-31"),
+		},
+		{
+			name: "unbounded end disjoint",
+			a:    dateRange("2023-02-01", ""),
+			b:    dateRange("2023-01-10", "2023-01-31"),
+			want: false,
+		},
+		{
+			name:   "both unbounded opposite sides",
+			a:      dateRange("", "2023-01-31"),
+			b:      dateRange("2023-01-01", ""),
+			want:   true,
+			wantIn: dateRange("2023-01-01", "2023-01-31"),
+		},
+		{
+			name:   "both unbounded same side",
+			a:      dateRange("", "2023-01-31"),
+			b:      dateRange("", "2023-01-15"),
+			want:   true,
+			wantIn: dateRange("", "2023-01-15"),
+		},
+		{
+			name:   "fully unbounded",
+			a:      dateRange("", ""),
+			b:      dateRange("2023-01-01", "2023-01-31"),
+			want:   true,
+			wantIn: dateRange("2023-01-01", "2023-01-31"),
+		},
+		{
+			name: "inverted range",
+			a:    dateRange("2023-01-31", "2023-01-01"),
+			b:    dateRange("2023-01-01", "2023-01-31"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, pair := range [][2]dbtypes.DateRange{{tt.a, tt.b}, {tt.b, tt.a}} {
+				a, b := pair[0], pair[1]
+				if got := a.Overlaps(b); got != tt.want {
+					t.Errorf("%s.Overlaps(%s) = %v, want %v", a, b, got, tt.want)
+				}
+
+				got, ok := a.Intersect(b)
+				if ok != tt.want || got.String() != tt.wantIn.String() {
+					t.Errorf("%s.Intersect(%s) = %s, %v, want %s, %v", a, b, got, ok, tt.wantIn, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDateRangeOverlapDays(t *testing.T) {
+	leave := dateRange("2023-01-25", "2023-02-05")
+
+	tests := []struct {
+		name   string
+		period dbtypes.DateRange
+		want   int
+	}{
+		{name: "january payroll", period: dateRange("2023-01-01", "2023-01-31"), want: 7},
+		{name: "february payroll", period: dateRange("2023-02-01", "2023-02-28"), want: 5},
+		{name: "leave inside period", period: dateRange("2023-01-01", "2023-03-31"), want: 12},
+		{name: "period inside leave", period: dateRange("2023-01-28", "2023-01-29"), want: 2},
+		{name: "single shared day start", period: dateRange("2022-12-01", "2023-01-25"), want: 1},
+		{name: "single shared day end", period: dateRange("2023-02-05", "2023-02-28"), want: 1},
+		{name: "disjoint", period: dateRange("2023-03-01", "2023-03-31"), want: 0},
+		{name: "unbounded period", period: dateRange("2023-02-01", ""), want: 5},
+		{name: "unbounded overlap", period: dateRange("", ""), want: 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := leave.OverlapDays(tt.period); got != tt.want {
+				t.Errorf("%s.OverlapDays(%s) = %d, want %d", leave, tt.period, got, tt.want)
+			}
+			if got := tt.period.OverlapDays(leave); got != tt.want {
+				t.Errorf("%s.OverlapDays(%s) = %d, want %d", tt.period, leave, got, tt.want)
+			}
+		})
+	}
+
+	if got := dateRange("", "2023-01-31").OverlapDays(dateRange("", "2023-01-15")); got != 0 {
+		t.Errorf("OverlapDays() of unbounded overlap = %d, want 0", got)
+	}
+}
+
+func TestDateRangeUnion(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          dbtypes.DateRange
+		mergeAdjacent bool
+		want          []dbtypes.DateRange
+	}{
+		{
+			name: "overlapping",
+			a:    dateRange("2023-01-01", "2023-01-15"),
+			b:    dateRange("2023-01-10", "2023-01-31"),
+			want: []dbtypes.DateRange{dateRange("2023-01-01", "2023-01-31")},
+		},
+		{
+			name: "contained",
+			a:    dateRange("2023-01-01", "2023-12-31"),
+			b:    dateRange("2023-03-01", "2023-03-31"),
+			want: []dbtypes.DateRange{dateRange("2023-01-01", "2023-12-31")},
+		},
+		{
+			name: "single shared day",
+			a:    dateRange("2023-01-01", "2023-01-10"),
+			b:    dateRange("2023-01-10", "2023-01-20"),
+			want: []dbtypes.DateRange{dateRange("2023-01-01", "2023-01-20")},
+		},
+		{
+			name:          "adjacent merged",
+			a:             dateRange("2023-01-01", "2023-01-09"),
+			b:             dateRange("2023-01-10", "2023-01-20"),
+			mergeAdjacent: true,
+			want:          []dbtypes.DateRange{dateRange("2023-01-01", "2023-01-20")},
+		},
+		{
+			name: "adjacent kept apart",
+			a:    dateRange("2023-01-01", "2023-01-09"),
+			b:    dateRange("2023-01-10", "2023-01-20"),
+			want: []dbtypes.DateRange{dateRange("2023-01-01", "2023-01-09"), dateRange("2023-01-10", "2023-01-20")},
+		},
+		{
+			name:          "disjoint",
+			a:             dateRange("2023-01-01", "2023-01-08"),
+			b:             dateRange("2023-01-10", "2023-01-20"),
+			mergeAdjacent: true,
+			want:          []dbtypes.DateRange{dateRange("2023-01-01", "2023-01-08"), dateRange("2023-01-10", "2023-01-20")},
+		},
+		{
+			name: "unbounded",
+			a:    dateRange("", "2023-01-15"),
+			b:    dateRange("2023-01-10", ""),
+			want: []dbtypes.DateRange{dateRange("", "")},
+		},
+		{
+			name: "unbounded start disjoint",
+			a:    dateRange("", "2023-01-05"),
+			b:    dateRange("2023-01-10", "2023-01-20"),
+			want: []dbtypes.DateRange{dateRange("", "2023-01-05"), dateRange("2023-01-10", "2023-01-20")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, pair := range [][2]dbtypes.DateRange{{tt.a, tt.b}, {tt.b, tt.a}} {
+				got := pair[0].Union(pair[1])
+				if tt.mergeAdjacent {
+					got = pair[0].UnionAdjacent(pair[1])
+				}
+				if len(got) != len(tt.want) {
+					t.Fatalf("%s.Union(%s) = %v, want %v", pair[0], pair[1], got, tt.want)
+				}
+				for i := range got {
+					if got[i].String() != tt.want[i].String() {
+						t.Errorf("%s.Union(%s) = %v, want %v", pair[0], pair[1], got, tt.want)
+					}
+				}
+			}
+		})
+	}
+}
+
+// checkPieces verifies that pieces are contiguous, non-overlapping
+// and exactly cover r.
+func checkPieces(t *testing.T, r dbtypes.DateRange, pieces []dbtypes.DateRange) {
+	t.Helper()
+
+	if len(pieces) == 0 {
+		t.Fatalf("split of %s returned no pieces", r)
+	}
+	if !pieces[0].Start.Equal(r.Start) || !pieces[len(pieces)-1].End.Equal(r.End) {
+		t.Errorf("pieces %v do not span %s", pieces, r)
+	}
+
+	days := 0
+	for i, piece := range pieces {
+		if !piece.IsValid() {
+			t.Errorf("piece %s is not valid", piece)
+		}
+		if i > 0 && !piece.Start.Equal(pieces[i-1].End.AddDays(1)) {
+			t.Errorf("piece %s does not follow %s", piece, pieces[i-1])
+		}
+		days += piece.Days()
+	}
+	if days != r.Days() {
+		t.Errorf("pieces %v cover %d days, want %d", pieces, days, r.Days())
+	}
+}
+
+func rangeStrings(pieces []dbtypes.DateRange) []string {
+	s := make([]string, len(pieces))
+	for i, piece := range pieces {
+		s[i] = piece.String()
+	}
+	return s
+}
+
+func TestDateRangeSplitByMonth(t *testing.T) {
+	tests := []struct {
+		r    dbtypes.DateRange
+		want []string
+	}{
+		{
+			r:    dateRange("2023-01-15", "2023-03-20"),
+			want: []string{"2023-01-15..2023-01-31", "2023-02-01..2023-02-28", "2023-03-01..2023-03-20"},
+		},
+		{
+			r:    dateRange("2024-01-31", "2024-03-01"),
+			want: []string{"2024-01-31..2024-01-31", "2024-02-01..2024-02-29", "2024-03-01..2024-03-01"},
+		},
+		{
+			r:    dateRange("2023-12-01", "2024-01-31"),
+			want: []string{"2023-12-01..2023-12-31", "2024-01-01..2024-01-31"},
+		},
+		{
+			r:    dateRange("2023-02-10", "2023-02-10"),
+			want: []string{"2023-02-10..2023-02-10"},
+		},
+	}
+
+	for _, tt := range tests {
+		pieces := tt.r.SplitByMonth()
+		checkPieces(t, tt.r, pieces)
+		if got := rangeStrings(pieces); !slices.Equal(got, tt.want) {
+			t.Errorf("%s.SplitByMonth() = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestDateRangeSplitByWeek(t *testing.T) {
+	r := dateRange("2023-10-04", "2023-10-20") // Wednesday to Friday
+
+	tests := []struct {
+		firstDay time.Weekday
+		want     []string
+	}{
+		{
+			firstDay: time.Monday,
+			want:     []string{"2023-10-04..2023-10-08", "2023-10-09..2023-10-15", "2023-10-16..2023-10-20"},
+		},
+		{
+			firstDay: time.Sunday,
+			want:     []string{"2023-10-04..2023-10-07", "2023-10-08..2023-10-14", "2023-10-15..2023-10-20"},
+		},
+		{
+			firstDay: time.Wednesday,
+			want:     []string{"2023-10-04..2023-10-10", "2023-10-11..2023-10-17", "2023-10-18..2023-10-20"},
+		},
+	}
+
+	for _, tt := range tests {
+		pieces := r.SplitByWeek(tt.firstDay)
+		checkPieces(t, r, pieces)
+		if got := rangeStrings(pieces); !slices.Equal(got, tt.want) {
+			t.Errorf("SplitByWeek(%s) = %v, want %v", tt.firstDay, got, tt.want)
+		}
+	}
+}
+
+func TestDateRangeSplitChunks(t *testing.T) {
+	r := dateRange("2023-01-15", "2023-03-20")
+
+	for _, days := range []int{1, 7, 10, 30, 65, 100} {
+		pieces := r.SplitChunks(days)
+		checkPieces(t, r, pieces)
+
+		for _, piece := range pieces[:len(pieces)-1] {
+			if piece.Days() != days {
+				t.Errorf("SplitChunks(%d) piece %s has %d days", days, piece, piece.Days())
+			}
+		}
+		if last := pieces[len(pieces)-1]; last.Days() > days {
+			t.Errorf("SplitChunks(%d) last piece %s has %d days", days, last, last.Days())
+		}
+	}
+}
+
+func TestDateRangeSplitInvalid(t *testing.T) {
+	for _, r := range []dbtypes.DateRange{
+		dateRange("2023-01-15", ""),
+		dateRange("", "2023-01-15"),
+		dateRange("2023-01-15", "2023-01-14"),
+	} {
+		if got := r.SplitByMonth(); got != nil {
+			t.Errorf("%s.SplitByMonth() = %v, want nil", r, got)
+		}
+		if got := r.SplitByWeek(time.Monday); got != nil {
+			t.Errorf("%s.SplitByWeek() = %v, want nil", r, got)
+		}
+		if got := r.SplitChunks(7); got != nil {
+			t.Errorf("%s.SplitChunks() = %v, want nil", r, got)
+		}
+	}
+
+	if got := dateRange("2023-01-01", "2023-01-31").SplitChunks(0); got != nil {
+		t.Errorf("SplitChunks(0) = %v, want nil", got)
+	}
+}
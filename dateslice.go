@@ -0,0 +1,56 @@
+package dbtypes
+
+import (
+	"slices"
+)
+
+// DateSlice is a slice of dates with helpers using calendar-day semantics
+// (see Date.Compare), so dates in different locations sort deterministically.
+type DateSlice []Date
+
+// Sort sorts the slice in ascending order in place.
+// The sort is stable: dates on the same calendar day keep their relative order.
+func (s DateSlice) Sort() {
+	slices.SortStableFunc(s, Date.Compare)
+}
+
+// SortDesc sorts the slice in descending order in place.
+// The sort is stable: dates on the same calendar day keep their relative order.
+func (s DateSlice) SortDesc() {
+	slices.SortStableFunc(s, func(a, b Date) int {
+		return b.Compare(a)
+	})
+}
+
+// Contains reports whether the slice contains a date on the same calendar day as date.
+func (s DateSlice) Contains(date Date) bool {
+	return s.IndexOf(date) >= 0
+}
+
+// IndexOf returns the index of the first date on the same calendar day as date, or -1.
+func (s DateSlice) IndexOf(date Date) int {
+	return slices.IndexFunc(s, date.Equal)
+}
+
+// Dedup returns a new slice without dates that fall on the same calendar day
+// as an earlier element. The order of the remaining dates is preserved.
+func (s DateSlice) Dedup() DateSlice {
+	seen := make(map[int64]struct{}, len(s))
+	result := make(DateSlice, 0, len(s))
+	for _, date := range s {
+		key := date.civilDays()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, date)
+	}
+	return result
+}
+
+// SearchDate searches for date in a slice sorted in ascending order and returns
+// the position where it is found, or the position where it would be inserted,
+// and whether it was found.
+func SearchDate(dates DateSlice, date Date) (int, bool) {
+	return slices.BinarySearchFunc(dates, date, Date.Compare)
+}
@@ -0,0 +1,111 @@
+package dbtypes_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func dateStrings(dates dbtypes.DateSlice) []string {
+	result := make([]string, len(dates))
+	for i, date := range dates {
+		result[i] = date.String()
+	}
+	return result
+}
+
+func shuffledDates() dbtypes.DateSlice {
+	east := time.FixedZone("UTC+3", 3*3600)
+	west := time.FixedZone("UTC-5", -5*3600)
+
+	return dbtypes.DateSlice{
+		dbtypes.NewDateIn(2023, time.March, 1, east),
+		{},
+		dbtypes.NewDateUTC(2021, time.December, 31),
+		dbtypes.Date(time.Date(2023, time.January, 1, 23, 0, 0, 0, west)),
+		dbtypes.NewDateIn(2023, time.March, 1, west),
+		dbtypes.NewDateIn(2022, time.June, 15, east),
+		{},
+		dbtypes.NewDateUTC(2023, time.January, 2),
+	}
+}
+
+func TestDateSliceSort(t *testing.T) {
+	dates := shuffledDates()
+	dates.Sort()
+
+	want := []string{"", "", "2021-12-31", "2022-06-15", "2023-01-01", "2023-01-02", "2023-03-01", "2023-03-01"}
+	if got := dateStrings(dates); !slices.Equal(got, want) {
+		t.Errorf("Sort() = %v, want %v", got, want)
+	}
+
+	// Stable: the east date was before the west date in the input.
+	if time.Time(dates[6]).Location().String() != "UTC+3" {
+		t.Errorf("Sort() is not stable for dates on the same day")
+	}
+
+	dates.SortDesc()
+	want = []string{"2023-03-01", "2023-03-01", "2023-01-02", "2023-01-01", "2022-06-15", "2021-12-31", "", ""}
+	if got := dateStrings(dates); !slices.Equal(got, want) {
+		t.Errorf("SortDesc() = %v, want %v", got, want)
+	}
+}
+
+func TestDateSliceContainsIndexOf(t *testing.T) {
+	dates := shuffledDates()
+
+	if i := dates.IndexOf(dbtypes.NewDateUTC(2023, time.March, 1)); i != 0 {
+		t.Errorf("IndexOf() = %d, want 0", i)
+	}
+	if i := dates.IndexOf(dbtypes.Date{}); i != 1 {
+		t.Errorf("IndexOf(zero) = %d, want 1", i)
+	}
+	if i := dates.IndexOf(dbtypes.NewDateUTC(2030, time.March, 1)); i != -1 {
+		t.Errorf("IndexOf() = %d, want -1", i)
+	}
+	if !dates.Contains(dbtypes.NewDateUTC(2023, time.January, 1)) {
+		t.Errorf("Contains() = false, want true")
+	}
+	if dates.Contains(dbtypes.NewDateUTC(2023, time.January, 3)) {
+		t.Errorf("Contains() = true, want false")
+	}
+}
+
+func TestDateSliceDedup(t *testing.T) {
+	dates := shuffledDates()
+	deduped := dates.Dedup()
+
+	want := []string{"2023-03-01", "", "2021-12-31", "2023-01-01", "2022-06-15", "2023-01-02"}
+	if got := dateStrings(deduped); !slices.Equal(got, want) {
+		t.Errorf("Dedup() = %v, want %v", got, want)
+	}
+	if len(dates) != 8 {
+		t.Errorf("Dedup() modified the receiver")
+	}
+}
+
+func TestSearchDate(t *testing.T) {
+	dates := shuffledDates().Dedup()
+	dates.Sort()
+
+	tests := []struct {
+		date      dbtypes.Date
+		wantIndex int
+		wantFound bool
+	}{
+		{date: dbtypes.Date{}, wantIndex: 0, wantFound: true},
+		{date: dbtypes.NewDateUTC(2023, time.January, 1), wantIndex: 3, wantFound: true},
+		{date: dbtypes.NewDateIn(2023, time.March, 1, time.FixedZone("UTC+9", 9*3600)), wantIndex: 5, wantFound: true},
+		{date: dbtypes.NewDateUTC(2022, time.January, 1), wantIndex: 2, wantFound: false},
+		{date: dbtypes.NewDateUTC(2024, time.January, 1), wantIndex: 6, wantFound: false},
+	}
+
+	for _, tt := range tests {
+		i, found := dbtypes.SearchDate(dates, tt.date)
+		if i != tt.wantIndex || found != tt.wantFound {
+			t.Errorf("SearchDate(%s) = %d, %v; want %d, %v", tt.date, i, found, tt.wantIndex, tt.wantFound)
+		}
+	}
+}
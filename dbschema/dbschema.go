@@ -0,0 +1,63 @@
+// Package dbschema describes dbtypes types as JSON Schemas for
+// github.com/invopop/jsonschema, so that generated API docs show dates as
+// date strings instead of their year/month/day internals.
+// It lives in its own package so that the core dbtypes package
+// does not depend on a JSON Schema generator.
+package dbschema
+
+import (
+	"reflect"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/invopop/jsonschema"
+)
+
+var (
+	dateType     = reflect.TypeOf(dbtypes.Date{})
+	nullDateType = reflect.TypeOf(dbtypes.NullDate{})
+	jsonType     = reflect.TypeOf(dbtypes.JSON{})
+)
+
+// Mapper is a jsonschema.Reflector Mapper for dbtypes.Date,
+// dbtypes.NullDate and dbtypes.JSON. It returns nil for other types.
+//
+//	r := &jsonschema.Reflector{Mapper: dbschema.Mapper}
+func Mapper(t reflect.Type) *jsonschema.Schema {
+	switch t {
+	case dateType:
+		return Date()
+	case nullDateType:
+		return NullDate()
+	case jsonType:
+		return JSON()
+	}
+	return nil
+}
+
+// Date describes a dbtypes.Date as a string in the "date" format
+// (yyyy-mm-dd) or null, since zero dates marshal to null.
+func Date() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		OneOf: []*jsonschema.Schema{
+			{
+				Type:     "string",
+				Format:   "date",
+				Examples: []interface{}{"2015-10-21"},
+			},
+			{Type: "null"},
+		},
+	}
+}
+
+// NullDate describes a dbtypes.NullDate as a date string or null.
+func NullDate() *jsonschema.Schema {
+	return Date()
+}
+
+// JSON describes a dbtypes.JSON as an object with arbitrary properties.
+func JSON() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:                 "object",
+		AdditionalProperties: jsonschema.TrueSchema,
+	}
+}
@@ -0,0 +1,82 @@
+package dbschema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/abiiranathan/dbtypes/dbschema"
+	"github.com/invopop/jsonschema"
+)
+
+type schemaRecord struct {
+	Born     dbtypes.Date     `json:"born"`
+	Died     *dbtypes.Date    `json:"died,omitempty"`
+	Reviewed dbtypes.NullDate `json:"reviewed"`
+	Details  dbtypes.JSON     `json:"details"`
+	Name     string           `json:"name"`
+}
+
+func TestMapperReflect(t *testing.T) {
+	r := &jsonschema.Reflector{DoNotReference: true, Mapper: dbschema.Mapper}
+	data, err := json.Marshal(r.Reflect(&schemaRecord{}))
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+
+	var schema struct {
+		Properties map[string]map[string]interface{} `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned error: %v", data, err)
+	}
+
+	date := map[string]interface{}{
+		"oneOf": []interface{}{
+			map[string]interface{}{"type": "string", "format": "date", "examples": []interface{}{"2015-10-21"}},
+			map[string]interface{}{"type": "null"},
+		},
+	}
+	tests := []struct {
+		property string
+		want     map[string]interface{}
+	}{
+		{property: "born", want: date},
+		{property: "died", want: date},
+		{property: "reviewed", want: date},
+		{property: "details", want: map[string]interface{}{"type": "object", "additionalProperties": true}},
+		{property: "name", want: map[string]interface{}{"type": "string"}},
+	}
+
+	for _, tt := range tests {
+		if got := schema.Properties[tt.property]; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("properties[%q] = %v, want %v", tt.property, got, tt.want)
+		}
+	}
+}
+
+func TestDateSchemaAllowsZeroDate(t *testing.T) {
+	// Zero dates marshal to null, so the schema must accept null.
+	data, err := json.Marshal(dbtypes.Date{})
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if string(data) != "null" {
+		t.Fatalf("json.Marshal(Date{}) = %s, want null", data)
+	}
+
+	var types []string
+	for _, s := range dbschema.Date().OneOf {
+		types = append(types, s.Type)
+	}
+	if want := []string{"string", "null"}; !reflect.DeepEqual(types, want) {
+		t.Errorf("Date().OneOf types = %v, want %v", types, want)
+	}
+}
+
+func TestMapperUnknownType(t *testing.T) {
+	if got := dbschema.Mapper(reflect.TypeOf("")); got != nil {
+		t.Errorf("Mapper(string) = %v, want nil", got)
+	}
+}
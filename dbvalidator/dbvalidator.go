@@ -0,0 +1,138 @@
+// Package dbvalidator registers dbtypes types and date validation tags
+// with github.com/go-playground/validator/v10.
+// It lives in its own package so that the core dbtypes package
+// does not depend on the validator.
+package dbvalidator
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterValidations registers dbtypes.Date, dbtypes.NullDate and
+// dbtypes.JSON with v so that tags like required and omitempty treat zero
+// dates, NULL dates and nil objects as empty, and adds the following tags
+// for date fields:
+//
+//	dbdate_before=Field  the date is before the date in Field
+//	dbdate_after=Field   the date is after the date in Field
+//	dbdate_past          the date is before dbtypes.Today()
+//	dbdate_future        the date is after dbtypes.Today()
+//
+// The param of dbdate_before and dbdate_after may also be a literal date
+// accepted by dbtypes.ParseDate. A zero referenced date is unbounded and always passes.
+// Zero dates fail every tag, so optional fields should use omitempty.
+//
+//	type Booking struct {
+//		Start dbtypes.Date `validate:"required,dbdate_before=End"`
+//		End   dbtypes.Date `validate:"omitempty,dbdate_future"`
+//	}
+func RegisterValidations(v *validator.Validate) error {
+	v.RegisterCustomTypeFunc(validatorValue, dbtypes.Date{}, dbtypes.NullDate{}, dbtypes.JSON{})
+
+	validations := map[string]validator.Func{
+		"dbdate_before": validateDateBefore,
+		"dbdate_after":  validateDateAfter,
+		"dbdate_past":   validateDatePast,
+		"dbdate_future": validateDateFuture,
+	}
+	for tag, fn := range validations {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatorValue maps dbtypes values to values the validator understands:
+// dates become time.Time and empty values become nil.
+func validatorValue(field reflect.Value) interface{} {
+	switch value := field.Interface().(type) {
+	case dbtypes.Date:
+		if value.IsZero() {
+			return nil
+		}
+		return time.Time(value)
+	case dbtypes.NullDate:
+		if !value.Valid || value.Date.IsZero() {
+			return nil
+		}
+		return time.Time(value.Date)
+	case dbtypes.JSON:
+		if value == nil {
+			return nil
+		}
+		return map[string]interface{}(value)
+	}
+	return nil
+}
+
+// fieldDate returns the date held by field after validatorValue has been applied.
+func fieldDate(field reflect.Value) (dbtypes.Date, bool) {
+	if !field.IsValid() || !field.CanInterface() {
+		return dbtypes.Date{}, false
+	}
+
+	switch value := field.Interface().(type) {
+	case time.Time:
+		return dbtypes.DateFromTime(value), !value.IsZero()
+	case dbtypes.Date:
+		return value, !value.IsZero()
+	case dbtypes.NullDate:
+		return value.Date, value.Valid && !value.Date.IsZero()
+	}
+	return dbtypes.Date{}, false
+}
+
+// paramDate returns the date referenced by the tag param, either a field of
+// the parent struct or a literal date. ok is false if the param is invalid.
+func paramDate(fl validator.FieldLevel) (date dbtypes.Date, ok bool) {
+	// The field is looked up directly because the validator reports zero
+	// dates, which validatorValue maps to nil, as missing fields.
+	if parent := reflect.Indirect(fl.Parent()); parent.Kind() == reflect.Struct {
+		if field := parent.FieldByName(fl.Param()); field.IsValid() && field.CanInterface() {
+			switch field.Interface().(type) {
+			case dbtypes.Date, dbtypes.NullDate, time.Time:
+				date, _ := fieldDate(field)
+				return date, true
+			}
+			return dbtypes.Date{}, false
+		}
+	}
+
+	date, err := dbtypes.ParseDate(fl.Param())
+	return date, err == nil
+}
+
+func validateDateBefore(fl validator.FieldLevel) bool {
+	date, ok := fieldDate(fl.Field())
+	if !ok {
+		return false
+	}
+
+	other, ok := paramDate(fl)
+	return ok && (other.IsZero() || date.Before(other))
+}
+
+func validateDateAfter(fl validator.FieldLevel) bool {
+	date, ok := fieldDate(fl.Field())
+	if !ok {
+		return false
+	}
+
+	other, ok := paramDate(fl)
+	return ok && (other.IsZero() || date.After(other))
+}
+
+func validateDatePast(fl validator.FieldLevel) bool {
+	date, ok := fieldDate(fl.Field())
+	return ok && date.Before(dbtypes.Today())
+}
+
+func validateDateFuture(fl validator.FieldLevel) bool {
+	date, ok := fieldDate(fl.Field())
+	return ok && date.After(dbtypes.Today())
+}
@@ -0,0 +1,93 @@
+package dbvalidator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/abiiranathan/dbtypes/dbvalidator"
+	"github.com/go-playground/validator/v10"
+)
+
+func newValidator(t *testing.T) *validator.Validate {
+	t.Helper()
+	v := validator.New()
+	if err := dbvalidator.RegisterValidations(v); err != nil {
+		t.Fatalf("RegisterValidations() returned error: %v", err)
+	}
+	return v
+}
+
+func TestValidateRequired(t *testing.T) {
+	type form struct {
+		Date     dbtypes.Date     `validate:"required"`
+		NullDate dbtypes.NullDate `validate:"required"`
+		Meta     dbtypes.JSON     `validate:"required"`
+	}
+
+	v := newValidator(t)
+	tests := []struct {
+		name    string
+		form    form
+		wantErr bool
+	}{
+		{
+			name: "set",
+			form: form{
+				Date:     dbtypes.Today(),
+				NullDate: dbtypes.NullDateFrom(dbtypes.Today()),
+				Meta:     dbtypes.JSON{},
+			},
+		},
+		{name: "zero date", form: form{NullDate: dbtypes.NullDateFrom(dbtypes.Today()), Meta: dbtypes.JSON{}}, wantErr: true},
+		{name: "null date", form: form{Date: dbtypes.Today(), Meta: dbtypes.JSON{}}, wantErr: true},
+		{name: "nil json", form: form{Date: dbtypes.Today(), NullDate: dbtypes.NullDateFrom(dbtypes.Today())}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.form)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Struct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDateTags(t *testing.T) {
+	type booking struct {
+		Start dbtypes.Date `validate:"required,dbdate_before=End,dbdate_after=2000-01-01"`
+		End   dbtypes.Date `validate:"omitempty,dbdate_after=Start"`
+	}
+	type event struct {
+		Held    dbtypes.Date     `validate:"omitempty,dbdate_past"`
+		Planned dbtypes.NullDate `validate:"omitempty,dbdate_future"`
+	}
+
+	v := newValidator(t)
+	start := dbtypes.NewDateUTC(2015, time.October, 21)
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "range", value: booking{Start: start, End: start.AddDays(1)}},
+		{name: "open range", value: booking{Start: start}},
+		{name: "reversed range", value: booking{Start: start, End: start.AddDays(-1)}, wantErr: true},
+		{name: "empty range", value: booking{Start: start, End: start}, wantErr: true},
+		{name: "before literal", value: booking{Start: dbtypes.NewDateUTC(1999, time.December, 31)}, wantErr: true},
+		{name: "past and future", value: event{Held: dbtypes.Yesterday(), Planned: dbtypes.NullDateFrom(dbtypes.Tomorrow())}},
+		{name: "unset", value: event{}},
+		{name: "today is not past", value: event{Held: dbtypes.Today()}, wantErr: true},
+		{name: "today is not future", value: event{Planned: dbtypes.NullDateFrom(dbtypes.Today())}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Struct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package dbtypes_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is a minimal database/sql driver that stores the arguments
+// of every INSERT statement as a row and returns all rows on SELECT.
+// It lets tests exercise Scanner/Valuer implementations through database/sql
+// without a real database.
+type fakeDriver struct {
+	mu     sync.Mutex
+	tables map[string]*fakeTable
+}
+
+type fakeTable struct {
+	mu   sync.Mutex
+	rows [][]driver.Value
+}
+
+var fakeDB = &fakeDriver{tables: make(map[string]*fakeTable)}
+
+func init() {
+	sql.Register("dbtypes_fake", fakeDB)
+}
+
+// openFakeDB opens a fresh in-memory table unique to the test.
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("dbtypes_fake", t.Name())
+	if err != nil {
+		t.Fatalf("Failed to open fake db: %v", err)
+	}
+
+	fakeDB.mu.Lock()
+	fakeDB.tables[t.Name()] = &fakeTable{}
+	fakeDB.mu.Unlock()
+
+	t.Cleanup(func() {
+		db.Close()
+		fakeDB.mu.Lock()
+		delete(fakeDB.tables, t.Name())
+		fakeDB.mu.Unlock()
+	})
+	return db
+}
+
+// insertFakeRow stores raw driver values as a row, bypassing any Valuer.
+// Useful to simulate the values a particular driver hands to Scan.
+func insertFakeRow(t *testing.T, values ...driver.Value) {
+	t.Helper()
+
+	fakeDB.mu.Lock()
+	table := fakeDB.tables[t.Name()]
+	fakeDB.mu.Unlock()
+
+	table.mu.Lock()
+	table.rows = append(table.rows, values)
+	table.mu.Unlock()
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	table, ok := d.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("fake driver: unknown table %q", name)
+	}
+	return &fakeConn{table: table}, nil
+}
+
+type fakeConn struct {
+	table *fakeTable
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("fake driver: transactions are not supported")
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !strings.HasPrefix(strings.ToUpper(s.query), "INSERT") {
+		return nil, fmt.Errorf("fake driver: unsupported exec %q", s.query)
+	}
+
+	s.conn.table.mu.Lock()
+	s.conn.table.rows = append(s.conn.table.rows, args)
+	s.conn.table.mu.Unlock()
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if !strings.HasPrefix(strings.ToUpper(s.query), "SELECT") {
+		return nil, fmt.Errorf("fake driver: unsupported query %q", s.query)
+	}
+
+	s.conn.table.mu.Lock()
+	rows := make([][]driver.Value, len(s.conn.table.rows))
+	copy(rows, s.conn.table.rows)
+	s.conn.table.mu.Unlock()
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	pos  int
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return []string{"c0"}
+	}
+
+	cols := make([]string, len(r.rows[0]))
+	for i := range cols {
+		cols[i] = fmt.Sprintf("c%d", i)
+	}
+	return cols
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+// fakeRowValues returns the raw driver values stored for the test's table.
+func fakeRowValues(t *testing.T) [][]driver.Value {
+	t.Helper()
+
+	fakeDB.mu.Lock()
+	table := fakeDB.tables[t.Name()]
+	fakeDB.mu.Unlock()
+
+	table.mu.Lock()
+	defer table.mu.Unlock()
+	return table.rows
+}
@@ -0,0 +1,54 @@
+package dbtypes
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptyDate is returned when parsing an empty date string.
+	ErrEmptyDate = errors.New("date is empty")
+
+	// ErrInvalidDateFormat is returned when a date does not match any accepted layout.
+	ErrInvalidDateFormat = errors.New("date should be of the format: yyyy-mm-dd")
+
+	// ErrDateOutOfRange is returned when a date has the right format
+	// but a component is out of range, e.g. 2015-02-30.
+	ErrDateOutOfRange = errors.New("date out of range")
+
+	// ErrDateTooLong is returned when a date string is longer than any
+	// accepted date could be.
+	ErrDateTooLong = errors.New("date is too long")
+
+	// ErrLayoutNoDay is returned by ParseDateLayout for layouts
+	// without a day of month or day of year component.
+	ErrLayoutNoDay = errors.New("layout has no day component")
+)
+
+// ParseError describes a failure to parse a date.
+// Use errors.Is with ErrEmptyDate, ErrDateTooLong, ErrInvalidDateFormat
+// or ErrDateOutOfRange to determine the cause.
+type ParseError struct {
+	Input  string // The input that failed to parse
+	Layout string // The layout the input was parsed with
+	Err    error  // The cause of the failure
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid date %q: %v", e.Input, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ScanTypeError is returned by Scan when the database value
+// has a Go type that cannot be converted.
+type ScanTypeError struct {
+	Value  interface{} // The value passed to Scan
+	Target string      // The name of the type being scanned into
+}
+
+func (e *ScanTypeError) Error() string {
+	return fmt.Sprintf("cannot scan %T into %s", e.Value, e.Target)
+}
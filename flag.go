@@ -0,0 +1,41 @@
+package dbtypes
+
+import (
+	"flag"
+	"strings"
+)
+
+// Set implements the flag.Value interface.
+// It parses s with ParseDate, falling back to the layouts of ParseDateAny.
+// An empty string resets the date to the zero value.
+func (date *Date) Set(s string) error {
+	if strings.TrimSpace(s) == "" {
+		*date = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(s)
+	if err != nil {
+		parsed, _, err = ParseDateAny(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	*date = parsed
+	return nil
+}
+
+// DateFlag defines a Date flag on fs with the given name, default value and usage.
+// If fs is nil, the flag is defined on flag.CommandLine.
+// The returned pointer holds the flag's value after fs.Parse.
+func DateFlag(fs *flag.FlagSet, name string, def Date, usage string) *Date {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	date := new(Date)
+	*date = def
+	fs.Var(date, name, usage)
+	return date
+}
@@ -0,0 +1,94 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateFlag(t *testing.T) {
+	def := dbtypes.NewDateUTC(2023, time.January, 1)
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    dbtypes.Date
+		wantErr bool
+	}{
+		{name: "default", args: nil, want: def},
+		{name: "iso", args: []string{"--from", "2023-10-21"}, want: dbtypes.NewDateUTC(2023, time.October, 21)},
+		{name: "equals", args: []string{"--from=2023-10-21"}, want: dbtypes.NewDateUTC(2023, time.October, 21)},
+		{name: "any layout", args: []string{"--from", "21/10/2023"}, want: dbtypes.NewDateUTC(2023, time.October, 21)},
+		{name: "empty resets", args: []string{"--from", ""}, want: dbtypes.Date{}},
+		{name: "invalid", args: []string{"--from", "yesterday"}, wantErr: true},
+		{name: "out of range", args: []string{"--from", "2023-02-30"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			from := dbtypes.DateFlag(fs, "from", def, "start date")
+
+			err := fs.Parse(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !from.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %s, want %s", tt.args, from, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateFlagDefaultValue(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	dbtypes.DateFlag(fs, "from", dbtypes.NewDateUTC(2023, time.January, 1), "start date")
+	dbtypes.DateFlag(fs, "to", dbtypes.Date{}, "end date")
+
+	if got := fs.Lookup("from").DefValue; got != "2023-01-01" {
+		t.Errorf("from DefValue = %q, want %q", got, "2023-01-01")
+	}
+	if got := fs.Lookup("to").DefValue; got != "" {
+		t.Errorf("to DefValue = %q, want %q", got, "")
+	}
+}
+
+func TestDateSet(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    dbtypes.Date
+		wantErr error
+	}{
+		{input: "2023-10-21", want: dbtypes.NewDateUTC(2023, time.October, 21)},
+		{input: "Oct 21, 2023", want: dbtypes.NewDateUTC(2023, time.October, 21)},
+		{input: "  ", want: dbtypes.Date{}},
+		{input: "yesterday", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023-02-30", wantErr: dbtypes.ErrDateOutOfRange},
+	}
+
+	for _, tt := range tests {
+		date := dbtypes.NewDateUTC(2000, time.January, 1)
+		err := date.Set(tt.input)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Set(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Set(%q) returned error: %v", tt.input, err)
+			continue
+		}
+		if !date.Equal(tt.want) || date.String() != tt.want.String() {
+			t.Errorf("Set(%q) = %s, want %s", tt.input, date, tt.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package dbtypes
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ordinalSuffix returns the English ordinal suffix for n: "st", "nd", "rd" or "th".
+func ordinalSuffix(n int) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// Ordinal returns the day of the month as an English ordinal like "1st", "22nd" or "13th".
+// Zero dates return an empty string.
+func (date Date) Ordinal() string {
+	if date.IsZero() {
+		return ""
+	}
+	return strconv.Itoa(date.Day()) + ordinalSuffix(date.Day())
+}
+
+// LongString returns the date in long English form like "21st October 2015".
+// Zero dates return an empty string.
+func (date Date) LongString() string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Ordinal() + " " + date.Format("January 2006")
+}
+
+// localeNames holds the month and weekday names of a locale.
+type localeNames struct {
+	months      [12]string
+	shortMonths [12]string
+	days        [7]string
+	shortDays   [7]string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*localeNames{}
+)
+
+func init() {
+	RegisterLocale("en",
+		[12]string{"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December"},
+		[7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	)
+}
+
+// LocaleOption configures a locale registered with RegisterLocale.
+type LocaleOption func(*localeNames)
+
+// LocaleShortMonths sets the month names used for the "Jan" verb, starting
+// with January. Use it when the first three characters of the long names
+// are ambiguous, like the French "juin" and "juillet".
+func LocaleShortMonths(names [12]string) LocaleOption {
+	return func(l *localeNames) {
+		l.shortMonths = names
+	}
+}
+
+// LocaleShortDays sets the weekday names used for the "Mon" verb,
+// starting with Sunday.
+func LocaleShortDays(names [7]string) LocaleOption {
+	return func(l *localeNames) {
+		l.shortDays = names
+	}
+}
+
+// RegisterLocale registers month and weekday names for use by FormatLocalized.
+// monthNames start with January and dayNames with Sunday (matching time.Weekday).
+// Short names used for the "Jan" and "Mon" verbs default to the first three
+// characters of the long names; override them with LocaleShortMonths and
+// LocaleShortDays. Registering an existing name replaces it.
+// English is registered as "en".
+func RegisterLocale(name string, monthNames [12]string, dayNames [7]string, opts ...LocaleOption) {
+	names := &localeNames{months: monthNames, days: dayNames}
+	for i, month := range monthNames {
+		names.shortMonths[i] = shortName(month)
+	}
+	for i, day := range dayNames {
+		names.shortDays[i] = shortName(day)
+	}
+	for _, opt := range opts {
+		opt(names)
+	}
+
+	localesMu.Lock()
+	locales[name] = names
+	localesMu.Unlock()
+}
+
+func shortName(name string) string {
+	runes := []rune(name)
+	if len(runes) > 3 {
+		runes = runes[:3]
+	}
+	return string(runes)
+}
+
+// lookupLocale returns the named locale, falling back to English.
+func lookupLocale(name string) *localeNames {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	if names, ok := locales[name]; ok {
+		return names
+	}
+	return locales["en"]
+}
+
+// FormatLocalized formats the date like Format but substitutes the month and
+// weekday names of the "January", "Jan", "Monday" and "Mon" layout verbs with
+// those of the locale registered under name (see RegisterLocale).
+// Unknown locales fall back to English. Zero dates return an empty string.
+func (date Date) FormatLocalized(layout string, locale string) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	names := lookupLocale(locale)
+	t := time.Time(date)
+
+	var b strings.Builder
+	start := 0
+	for i := 0; i < len(layout); i++ {
+		name, n := localizedVerb(layout[i:], names, t)
+		if n == 0 {
+			continue
+		}
+
+		b.WriteString(t.Format(layout[start:i]))
+		b.WriteString(name)
+		i += n - 1
+		start = i + 1
+	}
+	b.WriteString(t.Format(layout[start:]))
+	return b.String()
+}
+
+// localizedVerb reports whether layout starts with a month or weekday name verb,
+// using the same rules as the time package, and returns the localized name and
+// the length of the verb.
+func localizedVerb(layout string, names *localeNames, t time.Time) (string, int) {
+	switch {
+	case strings.HasPrefix(layout, "January"):
+		return names.months[t.Month()-1], len("January")
+	case strings.HasPrefix(layout, "Monday"):
+		return names.days[t.Weekday()], len("Monday")
+	case strings.HasPrefix(layout, "Jan") && !startsWithLower(layout[3:]):
+		return names.shortMonths[t.Month()-1], len("Jan")
+	case strings.HasPrefix(layout, "Mon") && !startsWithLower(layout[3:]):
+		return names.shortDays[t.Weekday()], len("Mon")
+	}
+	return "", 0
+}
+
+func startsWithLower(s string) bool {
+	return len(s) > 0 && 'a' <= s[0] && s[0] <= 'z'
+}
@@ -0,0 +1,133 @@
+package dbtypes_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateOrdinal(t *testing.T) {
+	want := []string{
+		"1st", "2nd", "3rd", "4th", "5th", "6th", "7th", "8th", "9th", "10th",
+		"11th", "12th", "13th", "14th", "15th", "16th", "17th", "18th", "19th", "20th",
+		"21st", "22nd", "23rd", "24th", "25th", "26th", "27th", "28th", "29th", "30th",
+		"31st",
+	}
+
+	for day := 1; day <= 31; day++ {
+		date := dbtypes.NewDate(2015, time.January, day)
+		if got := date.Ordinal(); got != want[day-1] {
+			t.Errorf("%s.Ordinal() = %q, want %q", date, got, want[day-1])
+		}
+	}
+
+	if got := (dbtypes.Date{}).Ordinal(); got != "" {
+		t.Errorf("Ordinal() of zero date = %q, want empty", got)
+	}
+}
+
+func TestDateLongString(t *testing.T) {
+	tests := []struct {
+		date dbtypes.Date
+		want string
+	}{
+		{date: dbtypes.NewDate(2015, time.October, 21), want: "21st October 2015"},
+		{date: dbtypes.NewDate(2023, time.February, 12), want: "12th February 2023"},
+		{date: dbtypes.NewDate(2024, time.March, 3), want: "3rd March 2024"},
+		{date: dbtypes.Date{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.date.LongString(); got != tt.want {
+			t.Errorf("LongString() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDateFormatLocalized(t *testing.T) {
+	dbtypes.RegisterLocale("fr",
+		[12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		[7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		dbtypes.LocaleShortMonths([12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc."}),
+		dbtypes.LocaleShortDays([7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."}),
+	)
+	dbtypes.RegisterLocale("fr-derived",
+		[12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		[7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	)
+	dbtypes.RegisterLocale("sw",
+		[12]string{"Januari", "Februari", "Machi", "Aprili", "Mei", "Juni",
+			"Julai", "Agosti", "Septemba", "Oktoba", "Novemba", "Desemba"},
+		[7]string{"Jumapili", "Jumatatu", "Jumanne", "Jumatano", "Alhamisi", "Ijumaa", "Jumamosi"},
+	)
+
+	date := dbtypes.NewDate(2015, time.August, 19) // a Wednesday
+
+	tests := []struct {
+		name   string
+		layout string
+		locale string
+		want   string
+	}{
+		{name: "english", layout: "Monday, 2 January 2006", locale: "en", want: "Wednesday, 19 August 2015"},
+		{name: "french long", layout: "Monday 2 January 2006", locale: "fr", want: "mercredi 19 août 2015"},
+		{name: "french short", layout: "Mon 02 Jan 2006", locale: "fr", want: "mer. 19 août 2015"},
+		{name: "derived short names", layout: "Mon 02 Jan 2006", locale: "fr-derived", want: "mer 19 aoû 2015"},
+		{name: "swahili long", layout: "Monday, 2 January 2006", locale: "sw", want: "Jumatano, 19 Agosti 2015"},
+		{name: "swahili short", layout: "Jan 2, 2006", locale: "sw", want: "Ago 19, 2015"},
+		{name: "numeric verbs untouched", layout: "2006-01-02", locale: "fr", want: "2015-08-19"},
+		{name: "lowercase after Mon is literal", layout: "Monsoon 2006", locale: "fr", want: "Monsoon 2015"},
+		{name: "unknown locale falls back to english", layout: "Monday 2 January 2006", locale: "xx", want: "Wednesday 19 August 2015"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := date.FormatLocalized(tt.layout, tt.locale); got != tt.want {
+				t.Errorf("FormatLocalized(%q, %q) = %q, want %q", tt.layout, tt.locale, got, tt.want)
+			}
+		})
+	}
+
+	if got := (dbtypes.Date{}).FormatLocalized("January", "fr"); got != "" {
+		t.Errorf("FormatLocalized() of zero date = %q, want empty", got)
+	}
+}
+
+func TestRegisterLocaleShortNameCollision(t *testing.T) {
+	months := [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre"}
+	days := [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"}
+	dbtypes.RegisterLocale("fr-collide", months, days)
+	dbtypes.RegisterLocale("fr-explicit", months, days, dbtypes.LocaleShortMonths([12]string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	}))
+
+	june := dbtypes.NewDate(2015, time.June, 1)
+	july := dbtypes.NewDate(2015, time.July, 1)
+
+	// The first three characters of "juin" and "juillet" are both "jui".
+	if got, other := june.FormatLocalized("Jan", "fr-collide"), july.FormatLocalized("Jan", "fr-collide"); got != other {
+		t.Errorf("derived short names = %q and %q, want the same prefix", got, other)
+	}
+
+	tests := []struct {
+		date dbtypes.Date
+		want string
+	}{
+		{date: june, want: "juin"},
+		{date: july, want: "juil."},
+	}
+	for _, tt := range tests {
+		if got := tt.date.FormatLocalized("Jan", "fr-explicit"); got != tt.want {
+			t.Errorf("FormatLocalized(%q) of %s = %q, want %q", "Jan", tt.date, got, tt.want)
+		}
+	}
+	if got := june.FormatLocalized("Mon", "fr-explicit"); got != "lun" {
+		t.Errorf("FormatLocalized(%q) of %s = %q, want %q", "Mon", june, got, "lun")
+	}
+}
@@ -0,0 +1,198 @@
+package dbtypes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// GormValue implements the gorm.Valuer interface.
+// Zero dates are written as NULL regardless of ZeroDateAsNull,
+// other dates are written as their Value. Errors from Value are added
+// to db, failing the statement.
+func (date Date) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	if date.IsZero() {
+		return clause.Expr{SQL: "NULL"}
+	}
+
+	value, err := date.Value()
+	if err != nil {
+		if db != nil {
+			db.AddError(err)
+		}
+		return clause.Expr{SQL: "NULL"}
+	}
+	return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+}
+
+// Column types by gorm dialector name, see gormDBDataType.
+var (
+	gormDateTypes = map[string]string{
+		"postgres":  "date",
+		"mysql":     "DATE",
+		"sqlite":    "date",
+		"sqlserver": "date",
+	}
+	gormJSONTypes = map[string]string{
+		"postgres":  "jsonb",
+		"mysql":     "JSON",
+		"sqlite":    "TEXT",
+		"sqlserver": "NVARCHAR(MAX)",
+	}
+	gormPeriodTypes = map[string]string{
+		"postgres":  "varchar(16)",
+		"mysql":     "VARCHAR(16)",
+		"sqlite":    "text",
+		"sqlserver": "NVARCHAR(16)",
+	}
+	gormYearTypes = map[string]string{
+		"postgres":  "integer",
+		"mysql":     "INT",
+		"sqlite":    "integer",
+		"sqlserver": "INT",
+	}
+)
+
+// gormDBDataType returns the column type for the dialector of db from types.
+// It returns "" for unknown dialectors and fields with an explicit type tag,
+// so that gorm falls back to the tag or GormDataType.
+func gormDBDataType(db *gorm.DB, field *schema.Field, types map[string]string) string {
+	if field != nil && field.TagSettings["TYPE"] != "" {
+		return ""
+	}
+	if db == nil || db.Config == nil || db.Dialector == nil {
+		return ""
+	}
+	return types[db.Dialector.Name()]
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface,
+// returning the date column type of the postgres, mysql, sqlite and
+// sqlserver dialectors. A `gorm:"type:..."` tag takes precedence.
+func (date Date) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormDateTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see Date.GormDBDataType).
+func (nd NullDate) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormDateTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface:
+// jsonb on postgres, JSON on mysql, TEXT on sqlite and NVARCHAR(MAX) on sqlserver.
+func (j JSON) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormJSONTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see JSON.GormDBDataType).
+func (nj NullJSON) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormJSONTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see JSON.GormDBDataType).
+func (r RawJSON) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormJSONTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see JSON.GormDBDataType).
+func (j JSONOf[T]) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormJSONTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see JSON.GormDBDataType).
+func (t TaggedJSON) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormJSONTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface,
+// returning a short text column type like varchar(16) for values like "2023-07".
+func (ym YearMonth) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormPeriodTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see YearMonth.GormDBDataType).
+func (w Week) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormPeriodTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface (see YearMonth.GormDBDataType).
+func (q Quarter) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormPeriodTypes)
+}
+
+// GormDBDataType implements the migrator.GormDataTypeInterface interface,
+// returning the integer column type of the dialector.
+func (y Year) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return gormDBDataType(db, field, gormYearTypes)
+}
+
+// DateSerializerName is the name DateSerializer is registered under by
+// RegisterGormSerializers, for use as `gorm:"serializer:dbtypes_date"`.
+const DateSerializerName = "dbtypes_date"
+
+// DateSerializer is a gorm serializer that stores Date, *Date and NullDate
+// fields in string-typed columns as yyyy-mm-dd. Zero and NULL dates are
+// stored as NULL, and NULL reads back as the zero Date, a nil *Date or an
+// invalid NullDate.
+type DateSerializer struct{}
+
+// RegisterGormSerializers registers DateSerializer as DateSerializerName.
+// Serializers are global in gorm, so db is only used to tie the call to
+// gorm initialization; it may be nil.
+func RegisterGormSerializers(db *gorm.DB) {
+	schema.RegisterSerializer(DateSerializerName, DateSerializer{})
+}
+
+// Scan implements the schema.SerializerInterface interface.
+func (DateSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var date Date
+	if err := date.Scan(dbValue); err != nil {
+		return err
+	}
+	valid := dbValue != nil
+
+	var value interface{}
+	switch field.FieldType {
+	case reflect.TypeOf(Date{}):
+		value = date
+	case reflect.TypeOf(&Date{}):
+		if valid {
+			value = &date
+		} else {
+			value = (*Date)(nil)
+		}
+	case reflect.TypeOf(NullDate{}):
+		value = NullDate{Date: date, Valid: valid}
+	default:
+		return fmt.Errorf("dbtypes: unsupported field type %s for serializer %s", field.FieldType, DateSerializerName)
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(reflect.ValueOf(value))
+	return nil
+}
+
+// Value implements the schema.SerializerInterface interface.
+func (DateSerializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var date Date
+	switch v := fieldValue.(type) {
+	case Date:
+		date = v
+	case *Date:
+		if v != nil {
+			date = *v
+		}
+	case NullDate:
+		date = v.DateOrZero()
+	default:
+		return nil, fmt.Errorf("dbtypes: unsupported field type %T for serializer %s", fieldValue, DateSerializerName)
+	}
+
+	if date.IsZero() {
+		return nil, nil
+	}
+	return date.String(), nil
+}
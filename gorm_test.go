@@ -0,0 +1,271 @@
+package dbtypes_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+type gormAppointment struct {
+	ID       uint
+	Date     dbtypes.Date
+	Text     dbtypes.Date     `gorm:"serializer:dbtypes_date;type:text"`
+	TextPtr  *dbtypes.Date    `gorm:"serializer:dbtypes_date;type:text"`
+	TextNull dbtypes.NullDate `gorm:"serializer:dbtypes_date;type:text"`
+}
+
+func openGormDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("Failed to open sqlite db: %v", err)
+	}
+	dbtypes.RegisterGormSerializers(db)
+
+	if err := db.AutoMigrate(&gormAppointment{}); err != nil {
+		t.Fatalf("AutoMigrate() returned error: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return db
+}
+
+func TestDateGorm(t *testing.T) {
+	db := openGormDB(t)
+	date := dbtypes.MustParseDate("2015-10-21")
+
+	tests := []struct {
+		name     string
+		row      gormAppointment
+		wantNull bool
+	}{
+		{
+			name: "normal",
+			row:  gormAppointment{Date: date, Text: date, TextPtr: &date, TextNull: dbtypes.NullDateFrom(date)},
+		},
+		{
+			name:     "zero",
+			row:      gormAppointment{},
+			wantNull: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := db.Create(&tt.row).Error; err != nil {
+				t.Fatalf("Create() returned error: %v", err)
+			}
+
+			var raw struct {
+				Date     *string
+				Text     *string
+				TextPtr  *string
+				TextNull *string
+			}
+			if err := db.Table("gorm_appointments").Where("id = ?", tt.row.ID).Take(&raw).Error; err != nil {
+				t.Fatalf("Take() returned error: %v", err)
+			}
+			for name, col := range map[string]*string{"date": raw.Date, "text": raw.Text, "text_ptr": raw.TextPtr, "text_null": raw.TextNull} {
+				if (col == nil) != tt.wantNull {
+					t.Errorf("column %s = %v, want NULL %v", name, col, tt.wantNull)
+				}
+			}
+			if !tt.wantNull && *raw.Text != "2015-10-21" {
+				t.Errorf("column text = %q, want %q", *raw.Text, "2015-10-21")
+			}
+
+			var got gormAppointment
+			if err := db.First(&got, tt.row.ID).Error; err != nil {
+				t.Fatalf("First() returned error: %v", err)
+			}
+			if !got.Date.Equal(tt.row.Date) || got.Date.IsZero() != tt.wantNull {
+				t.Errorf("Date = %s, want %s", got.Date, tt.row.Date)
+			}
+			if !got.Text.Equal(tt.row.Text) || got.Text.IsZero() != tt.wantNull {
+				t.Errorf("Text = %s, want %s", got.Text, tt.row.Text)
+			}
+			if (got.TextPtr == nil) != tt.wantNull || (got.TextPtr != nil && !got.TextPtr.Equal(date)) {
+				t.Errorf("TextPtr = %v, want %v", got.TextPtr, tt.row.TextPtr)
+			}
+			if got.TextNull.Valid == tt.wantNull || !got.TextNull.Date.Equal(tt.row.TextNull.Date) {
+				t.Errorf("TextNull = %+v, want %+v", got.TextNull, tt.row.TextNull)
+			}
+		})
+	}
+}
+
+func TestDateGormScanNull(t *testing.T) {
+	db := openGormDB(t)
+
+	err := db.Exec("INSERT INTO gorm_appointments (id, date, text, text_ptr, text_null) VALUES (1, NULL, NULL, NULL, NULL)").Error
+	if err != nil {
+		t.Fatalf("Exec() returned error: %v", err)
+	}
+
+	var got gormAppointment
+	if err := db.First(&got, 1).Error; err != nil {
+		t.Fatalf("First() returned error: %v", err)
+	}
+	if !got.Date.IsZero() || !got.Text.IsZero() || got.TextPtr != nil || got.TextNull.Valid {
+		t.Errorf("First() = %+v, want zero dates", got)
+	}
+}
+
+// namedDialector reports a different dialector name, so that the column types
+// of databases that are not available in tests can be checked.
+type namedDialector struct {
+	gorm.Dialector
+	name string
+}
+
+func (d namedDialector) Name() string {
+	return d.name
+}
+
+type gormDataTyper interface {
+	GormDBDataType(db *gorm.DB, field *schema.Field) string
+}
+
+func TestGormDBDataType(t *testing.T) {
+	dateTypes := map[string]string{"postgres": "date", "mysql": "DATE", "sqlite": "date", "sqlserver": "date", "oracle": ""}
+	jsonTypes := map[string]string{"postgres": "jsonb", "mysql": "JSON", "sqlite": "TEXT", "sqlserver": "NVARCHAR(MAX)", "oracle": ""}
+	periodTypes := map[string]string{"postgres": "varchar(16)", "mysql": "VARCHAR(16)", "sqlite": "text", "sqlserver": "NVARCHAR(16)", "oracle": ""}
+	yearTypes := map[string]string{"postgres": "integer", "mysql": "INT", "sqlite": "integer", "sqlserver": "INT", "oracle": ""}
+
+	tests := []struct {
+		name  string
+		value gormDataTyper
+		want  map[string]string
+	}{
+		{"Date", dbtypes.Date{}, dateTypes},
+		{"NullDate", dbtypes.NullDate{}, dateTypes},
+		{"JSON", dbtypes.JSON{}, jsonTypes},
+		{"RawJSON", dbtypes.RawJSON{}, jsonTypes},
+		{"JSONOf", dbtypes.JSONOf[[]int]{}, jsonTypes},
+		{"NullJSON", dbtypes.NullJSON{}, jsonTypes},
+		{"TaggedJSON", dbtypes.TaggedJSON{}, jsonTypes},
+		{"YearMonth", dbtypes.YearMonth{}, periodTypes},
+		{"Week", dbtypes.Week{}, periodTypes},
+		{"Quarter", dbtypes.Quarter{}, periodTypes},
+		{"Year", dbtypes.Year(0), yearTypes},
+	}
+
+	for _, tt := range tests {
+		for dialect, want := range tt.want {
+			db := &gorm.DB{Config: &gorm.Config{Dialector: namedDialector{sqlite.Open(""), dialect}}}
+			if got := tt.value.GormDBDataType(db, nil); got != want {
+				t.Errorf("%s.GormDBDataType(%s) = %q, want %q", tt.name, dialect, got, want)
+			}
+		}
+
+		if got := tt.value.GormDBDataType(nil, nil); got != "" {
+			t.Errorf("%s.GormDBDataType(nil) = %q, want empty", tt.name, got)
+		}
+	}
+}
+
+func TestGormDBDataTypeMigrate(t *testing.T) {
+	type gormDocument struct {
+		ID       uint
+		Issued   dbtypes.Date
+		Body     dbtypes.JSON
+		Raw      dbtypes.RawJSON
+		Settings dbtypes.JSONOf[map[string]string]
+	}
+
+	db := openGormDB(t)
+	if err := db.AutoMigrate(&gormDocument{}); err != nil {
+		t.Fatalf("AutoMigrate() returned error: %v", err)
+	}
+
+	columns, err := db.Migrator().ColumnTypes(&gormDocument{})
+	if err != nil {
+		t.Fatalf("ColumnTypes() returned error: %v", err)
+	}
+
+	want := map[string]string{"issued": "date", "body": "TEXT", "raw": "TEXT", "settings": "TEXT"}
+	for _, col := range columns {
+		if typ, ok := want[col.Name()]; ok && !strings.EqualFold(col.DatabaseTypeName(), typ) {
+			t.Errorf("column %s type = %s, want %s", col.Name(), col.DatabaseTypeName(), typ)
+		}
+	}
+
+	body := dbtypes.JSON{"title": "Invoice"}
+	doc := gormDocument{Issued: dbtypes.MustParseDate("2015-10-21"), Body: body, Raw: dbtypes.RawJSON(`{"b":1,"a":2}`), Settings: dbtypes.NewJSONOf(map[string]string{"theme": "dark"})}
+	if err := db.Create(&doc).Error; err != nil {
+		t.Fatalf("Create() returned error: %v", err)
+	}
+
+	var got gormDocument
+	if err := db.First(&got, doc.ID).Error; err != nil {
+		t.Fatalf("First() returned error: %v", err)
+	}
+	if !got.Body.Equal(body) || string(got.Raw) != `{"b":1,"a":2}` || got.Settings.Data["theme"] != "dark" {
+		t.Errorf("First() = %+v, want %+v", got, doc)
+	}
+}
+
+func TestGormDBDataTypeMigratePeriods(t *testing.T) {
+	type gormReport struct {
+		ID      uint
+		Month   dbtypes.YearMonth
+		Week    dbtypes.Week
+		Quarter dbtypes.Quarter
+		Year    dbtypes.Year
+	}
+
+	db := openGormDB(t)
+	if err := db.AutoMigrate(&gormReport{}); err != nil {
+		t.Fatalf("AutoMigrate() returned error: %v", err)
+	}
+
+	columns, err := db.Migrator().ColumnTypes(&gormReport{})
+	if err != nil {
+		t.Fatalf("ColumnTypes() returned error: %v", err)
+	}
+
+	want := map[string]string{"month": "text", "week": "text", "quarter": "text", "year": "integer"}
+	for _, col := range columns {
+		if typ, ok := want[col.Name()]; ok && !strings.EqualFold(col.DatabaseTypeName(), typ) {
+			t.Errorf("column %s type = %s, want %s", col.Name(), col.DatabaseTypeName(), typ)
+		}
+	}
+
+	week, _ := dbtypes.NewWeek(2023, 42)
+	quarter, _ := dbtypes.NewQuarter(2023, 3)
+	report := gormReport{Month: dbtypes.NewYearMonth(2023, time.July), Week: week, Quarter: quarter, Year: 2023}
+	if err := db.Create(&report).Error; err != nil {
+		t.Fatalf("Create() returned error: %v", err)
+	}
+
+	var got gormReport
+	if err := db.First(&got, report.ID).Error; err != nil {
+		t.Fatalf("First() returned error: %v", err)
+	}
+	if got != report {
+		t.Errorf("First() = %+v, want %+v", got, report)
+	}
+}
+
+func TestGormDBDataTypeTypeTag(t *testing.T) {
+	db := &gorm.DB{Config: &gorm.Config{Dialector: namedDialector{sqlite.Open(""), "postgres"}}}
+	field := &schema.Field{TagSettings: map[string]string{"TYPE": "text"}}
+
+	if got := (dbtypes.Date{}).GormDBDataType(db, field); got != "" {
+		t.Errorf("Date.GormDBDataType() with a type tag = %q, want empty", got)
+	}
+	if got := (dbtypes.JSON{}).GormDBDataType(db, field); got != "" {
+		t.Errorf("JSON.GormDBDataType() with a type tag = %q, want empty", got)
+	}
+}
@@ -0,0 +1,79 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// gqlKind describes the kind of a GraphQL input value for error messages.
+func gqlKind(v interface{}) string {
+	switch v.(type) {
+	case int, int32, int64, float32, float64, json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "list"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// MarshalGQL implements the graphql.Marshaler interface of gqlgen.
+// The date is written as a quoted yyyy-mm-dd string, or null for zero dates.
+func (date Date) MarshalGQL(w io.Writer) {
+	b, _ := date.MarshalJSON()
+	w.Write(b)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+// It accepts a string in the formats accepted by UnmarshalText and null.
+func (date *Date) UnmarshalGQL(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		*date = Date{}
+		return nil
+	case string:
+		return date.UnmarshalText([]byte(v))
+	}
+	return fmt.Errorf("Date must be a string in yyyy-mm-dd format, got %s", gqlKind(v))
+}
+
+// MarshalGQL implements the graphql.Marshaler interface of gqlgen,
+// writing the object as JSON.
+func (j JSON) MarshalGQL(w io.Writer) {
+	b, err := json.Marshal(j)
+	if err != nil {
+		b = []byte("null")
+	}
+	w.Write(b)
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface of gqlgen.
+// It accepts an input object, a string containing a JSON object, or null.
+func (j *JSON) UnmarshalGQL(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		*j = nil
+		return nil
+	case map[string]interface{}:
+		*j = JSON(v)
+		return nil
+	case string:
+		if err := checkJSONLimits([]byte(v)); err != nil {
+			return err
+		}
+		m, err := decodeJSONObject([]byte(v))
+		if err != nil {
+			return fmt.Errorf("JSON must be an object or a string containing a JSON object: %w", err)
+		}
+		if err := validateJSON([]byte(v)); err != nil {
+			return err
+		}
+		*j = JSON(m)
+		return nil
+	}
+	return fmt.Errorf("JSON must be an object or a string containing a JSON object, got %s", gqlKind(v))
+}
@@ -0,0 +1,158 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateMarshalGQL(t *testing.T) {
+	tests := []struct {
+		date dbtypes.Date
+		want string
+	}{
+		{date: dbtypes.NewDateUTC(2015, time.October, 21), want: `"2015-10-21"`},
+		{date: dbtypes.Date{}, want: `null`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		tt.date.MarshalGQL(&buf)
+		if buf.String() != tt.want {
+			t.Errorf("MarshalGQL(%s) = %s, want %s", tt.date, buf.String(), tt.want)
+		}
+	}
+}
+
+func TestDateUnmarshalGQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    string
+		wantErr string
+	}{
+		{name: "string", input: "2015-10-21", want: "2015-10-21"},
+		{name: "timestamp", input: "2015-10-21T16:29:00Z", want: "2015-10-21"},
+		{name: "empty string", input: "", want: ""},
+		{name: "null", input: nil, want: ""},
+		{name: "invalid string", input: "21/10/2015", wantErr: "yyyy-mm-dd"},
+		{name: "int", input: 20151021, wantErr: "got number"},
+		{name: "int64", input: int64(20151021), wantErr: "got number"},
+		{name: "json number", input: json.Number("20151021"), wantErr: "got number"},
+		{name: "float", input: 2015.5, wantErr: "got number"},
+		{name: "bool", input: true, wantErr: "got boolean"},
+		{name: "list", input: []interface{}{"2015-10-21"}, wantErr: "got list"},
+		{name: "object", input: map[string]interface{}{"date": "2015-10-21"}, wantErr: "got object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date := dbtypes.Today()
+			err := date.UnmarshalGQL(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("UnmarshalGQL(%v) error = %v, want error containing %q", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnmarshalGQL(%v) returned error: %v", tt.input, err)
+			}
+			if date.String() != tt.want {
+				t.Errorf("UnmarshalGQL(%v) = %s, want %s", tt.input, date, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONMarshalGQL(t *testing.T) {
+	tests := []struct {
+		value dbtypes.JSON
+		want  string
+	}{
+		{value: dbtypes.JSON{"name": "doc", "age": 65}, want: `{"age":65,"name":"doc"}`},
+		{value: dbtypes.JSON{}, want: `{}`},
+		{value: nil, want: `null`},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		tt.value.MarshalGQL(&buf)
+		if buf.String() != tt.want {
+			t.Errorf("MarshalGQL(%v) = %s, want %s", tt.value, buf.String(), tt.want)
+		}
+	}
+}
+
+func TestJSONUnmarshalGQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    dbtypes.JSON
+		wantErr string
+	}{
+		{
+			name:  "object",
+			input: map[string]interface{}{"name": "doc", "tags": []interface{}{"a"}},
+			want:  dbtypes.JSON{"name": "doc", "tags": []interface{}{"a"}},
+		},
+		{name: "json string", input: `{"name":"doc","age":65}`, want: dbtypes.JSON{"name": "doc", "age": float64(65)}},
+		{name: "null", input: nil, want: nil},
+		{name: "invalid json string", input: `{"name":`, wantErr: "string containing a JSON object"},
+		{name: "json array string", input: `[1, 2]`, wantErr: "string containing a JSON object"},
+		{name: "number", input: 42, wantErr: "got number"},
+		{name: "list", input: []interface{}{1, 2}, wantErr: "got list"},
+		{name: "bool", input: false, wantErr: "got boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dbtypes.JSON{"stale": true}
+			err := got.UnmarshalGQL(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("UnmarshalGQL(%v) error = %v, want error containing %q", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnmarshalGQL(%v) returned error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalGQL(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONUnmarshalGQLDecodeOptions(t *testing.T) {
+	dbtypes.JSONUseNumber(true)
+	defer dbtypes.JSONUseNumber(false)
+
+	var j dbtypes.JSON
+	if err := j.UnmarshalGQL(`{"id":12345678901234567890}`); err != nil {
+		t.Fatalf("UnmarshalGQL() returned error: %v", err)
+	}
+	if got, want := j["id"], json.Number("12345678901234567890"); got != want {
+		t.Errorf("UnmarshalGQL() id = %#v, want %#v", got, want)
+	}
+
+	if err := j.UnmarshalGQL(`{"a":1} {"b":2}`); err == nil {
+		t.Errorf("UnmarshalGQL() of trailing data error = nil, want error")
+	}
+
+	setInvoiceValidator(t)
+	var validationErr *dbtypes.JSONValidationError
+	if err := j.UnmarshalGQL(`{"items":[]}`); !errors.As(err, &validationErr) || !errors.Is(err, errNoNumber) {
+		t.Errorf("UnmarshalGQL() error = %v, want *JSONValidationError wrapping errNoNumber", err)
+	}
+	if err := j.UnmarshalGQL(`{"number":"INV-1"}`); err != nil {
+		t.Errorf("UnmarshalGQL() returned error: %v", err)
+	}
+}
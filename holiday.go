@@ -0,0 +1,114 @@
+package dbtypes
+
+import (
+	"sync"
+	"time"
+)
+
+// monthDay identifies a recurring yearly date.
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+// HolidayCalendar is a set of holidays used by business-day arithmetic.
+// Holidays are either fixed dates or recurring month/day rules that apply
+// to every year (e.g. Dec 25). The zero value is an empty calendar ready to
+// use. A HolidayCalendar is safe for concurrent use.
+type HolidayCalendar struct {
+	mu        sync.RWMutex
+	dates     map[int64]struct{}
+	recurring map[monthDay]struct{}
+}
+
+// NewHolidayCalendar returns a calendar containing the given fixed holidays.
+func NewHolidayCalendar(dates ...Date) *HolidayCalendar {
+	cal := &HolidayCalendar{
+		dates:     make(map[int64]struct{}),
+		recurring: make(map[monthDay]struct{}),
+	}
+	cal.Add(dates...)
+	return cal
+}
+
+// Add adds fixed holidays to the calendar.
+func (cal *HolidayCalendar) Add(dates ...Date) {
+	cal.mu.Lock()
+	defer cal.mu.Unlock()
+
+	if cal.dates == nil {
+		cal.dates = make(map[int64]struct{})
+	}
+	for _, date := range dates {
+		cal.dates[date.civilDays()] = struct{}{}
+	}
+}
+
+// AddRecurring adds a holiday that falls on month and day every year.
+func (cal *HolidayCalendar) AddRecurring(month time.Month, day int) {
+	cal.mu.Lock()
+	defer cal.mu.Unlock()
+
+	if cal.recurring == nil {
+		cal.recurring = make(map[monthDay]struct{})
+	}
+	cal.recurring[monthDay{month: month, day: day}] = struct{}{}
+}
+
+// IsHoliday reports whether the date is a holiday in the calendar.
+// A nil calendar has no holidays.
+func (cal *HolidayCalendar) IsHoliday(date Date) bool {
+	if cal == nil {
+		return false
+	}
+
+	cal.mu.RLock()
+	defer cal.mu.RUnlock()
+
+	if _, ok := cal.dates[date.civilDays()]; ok {
+		return true
+	}
+
+	_, m, d := date.civil()
+	_, ok := cal.recurring[monthDay{month: m, day: d}]
+	return ok
+}
+
+// Merge returns a new calendar containing the holidays of cal and all others.
+// The receiver and others are not modified.
+func (cal *HolidayCalendar) Merge(others ...*HolidayCalendar) *HolidayCalendar {
+	merged := NewHolidayCalendar()
+	for _, c := range append([]*HolidayCalendar{cal}, others...) {
+		if c == nil {
+			continue
+		}
+
+		c.mu.RLock()
+		for key := range c.dates {
+			merged.dates[key] = struct{}{}
+		}
+		for key := range c.recurring {
+			merged.recurring[key] = struct{}{}
+		}
+		c.mu.RUnlock()
+	}
+	return merged
+}
+
+// isBusinessDay reports whether the date is neither a weekend day nor a holiday.
+func (cal *HolidayCalendar) isBusinessDay(date Date) bool {
+	return date.IsBusinessDay() && !cal.IsHoliday(date)
+}
+
+// AddBusinessDaysCal is like AddBusinessDays but also skips the holidays in cal.
+// A nil cal behaves like AddBusinessDays. If the weekend and holidays leave no
+// business day within a year of a step, the date is returned unchanged.
+func (date Date) AddBusinessDaysCal(n int, cal *HolidayCalendar) Date {
+	return addBusinessDays(date, n, cal.isBusinessDay)
+}
+
+// BusinessDaysBetweenCal is like BusinessDaysBetween but does not count the holidays in cal.
+// A nil cal behaves like BusinessDaysBetween.
+func (date Date) BusinessDaysBetweenCal(other Date, cal *HolidayCalendar) int {
+	return businessDaysBetween(date, other, cal.isBusinessDay)
+}
@@ -0,0 +1,174 @@
+package dbtypes_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// fixtureCalendar returns a small calendar for October 2023:
+// Oct 9 (Monday, fixed), Oct 14 (Saturday, fixed) and Dec 25/26 recurring.
+func fixtureCalendar() *dbtypes.HolidayCalendar {
+	cal := dbtypes.NewHolidayCalendar(
+		dbtypes.NewDate(2023, time.October, 9),
+		dbtypes.NewDate(2023, time.October, 14),
+	)
+	cal.AddRecurring(time.December, 25)
+	cal.AddRecurring(time.December, 26)
+	return cal
+}
+
+func TestHolidayCalendarIsHoliday(t *testing.T) {
+	cal := fixtureCalendar()
+
+	tests := []struct {
+		date dbtypes.Date
+		want bool
+	}{
+		{date: dbtypes.NewDate(2023, time.October, 9), want: true},
+		{date: dbtypes.NewDateIn(2023, time.October, 9, time.FixedZone("UTC-5", -5*3600)), want: true},
+		{date: dbtypes.NewDate(2024, time.October, 9), want: false},
+		{date: dbtypes.NewDate(2023, time.October, 10), want: false},
+		{date: dbtypes.NewDate(2023, time.December, 25), want: true},
+		{date: dbtypes.NewDate(2030, time.December, 26), want: true},
+	}
+
+	for _, tt := range tests {
+		if got := cal.IsHoliday(tt.date); got != tt.want {
+			t.Errorf("IsHoliday(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	var nilCal *dbtypes.HolidayCalendar
+	if nilCal.IsHoliday(dbtypes.NewDate(2023, time.December, 25)) {
+		t.Errorf("nil calendar should have no holidays")
+	}
+}
+
+func TestAddBusinessDaysCal(t *testing.T) {
+	cal := fixtureCalendar()
+
+	tests := []struct {
+		name string
+		date dbtypes.Date
+		n    int
+		want string
+	}{
+		{name: "skips monday holiday", date: dbtypes.NewDate(2023, time.October, 6), n: 1, want: "2023-10-10"},
+		{name: "holiday on weekend changes nothing", date: dbtypes.NewDate(2023, time.October, 13), n: 1, want: "2023-10-16"},
+		{name: "backwards over holiday", date: dbtypes.NewDate(2023, time.October, 10), n: -1, want: "2023-10-06"},
+		{name: "recurring holidays", date: dbtypes.NewDate(2024, time.December, 24), n: 1, want: "2024-12-27"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.AddBusinessDaysCal(tt.n, cal); got.String() != tt.want {
+				t.Errorf("AddBusinessDaysCal(%d) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+
+	date := dbtypes.NewDate(2023, time.October, 6)
+	if got, want := date.AddBusinessDaysCal(1, nil), date.AddBusinessDays(1); !got.Equal(want) {
+		t.Errorf("AddBusinessDaysCal(1, nil) = %s, want %s", got, want)
+	}
+}
+
+func TestBusinessDaysBetweenCal(t *testing.T) {
+	cal := fixtureCalendar()
+
+	// Oct 6 (Fri) to Oct 20 (Fri): 10 weekdays, minus Oct 9. Oct 14 is a Saturday.
+	start := dbtypes.NewDate(2023, time.October, 6)
+	end := dbtypes.NewDate(2023, time.October, 20)
+	if got := start.BusinessDaysBetweenCal(end, cal); got != 9 {
+		t.Errorf("BusinessDaysBetweenCal() = %d, want 9", got)
+	}
+	if got := start.BusinessDaysBetweenCal(end, nil); got != 10 {
+		t.Errorf("BusinessDaysBetweenCal(nil) = %d, want 10", got)
+	}
+}
+
+func TestHolidayCalendarMerge(t *testing.T) {
+	a := dbtypes.NewHolidayCalendar(dbtypes.NewDate(2023, time.January, 2))
+	b := dbtypes.NewHolidayCalendar(dbtypes.NewDate(2023, time.February, 3))
+	b.AddRecurring(time.May, 1)
+
+	merged := a.Merge(b, nil)
+	for _, date := range []dbtypes.Date{
+		dbtypes.NewDate(2023, time.January, 2),
+		dbtypes.NewDate(2023, time.February, 3),
+		dbtypes.NewDate(2025, time.May, 1),
+	} {
+		if !merged.IsHoliday(date) {
+			t.Errorf("merged.IsHoliday(%s) = false, want true", date)
+		}
+	}
+
+	if a.IsHoliday(dbtypes.NewDate(2023, time.February, 3)) {
+		t.Errorf("Merge() modified the receiver")
+	}
+}
+
+func TestHolidayCalendarConcurrentReads(t *testing.T) {
+	cal := fixtureCalendar()
+	start := dbtypes.NewDate(2023, time.January, 1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start.BusinessDaysBetweenCal(start.AddYears(1), cal)
+		}()
+	}
+
+	cal.Add(dbtypes.NewDate(2023, time.June, 1))
+	wg.Wait()
+}
+
+func TestHolidayCalendarZeroValue(t *testing.T) {
+	var cal dbtypes.HolidayCalendar
+	cal.Add(dbtypes.NewDate(2023, time.October, 9))
+	cal.AddRecurring(time.December, 25)
+
+	tests := []struct {
+		date dbtypes.Date
+		want bool
+	}{
+		{date: dbtypes.NewDate(2023, time.October, 9), want: true},
+		{date: dbtypes.NewDate(2030, time.December, 25), want: true},
+		{date: dbtypes.NewDate(2023, time.October, 10), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := cal.IsHoliday(tt.date); got != tt.want {
+			t.Errorf("IsHoliday(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	var empty dbtypes.HolidayCalendar
+	if empty.IsHoliday(dbtypes.NewDate(2023, time.October, 9)) {
+		t.Errorf("IsHoliday() of empty calendar = true, want false")
+	}
+}
+
+func TestAddBusinessDaysCalNoBusinessDays(t *testing.T) {
+	// Every day but Monday is a weekend day and every day of the year is a
+	// recurring holiday, so there are no business days at all.
+	dbtypes.SetWeekend(time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday)
+	defer dbtypes.SetWeekend()
+
+	cal := dbtypes.NewHolidayCalendar()
+	for day := dbtypes.NewDate(2024, time.January, 1); day.Year() == 2024; day = day.AddDays(1) {
+		cal.AddRecurring(time.Month(day.Month()), day.Day())
+	}
+
+	date := dbtypes.NewDate(2023, time.October, 9)
+	for _, n := range []int{1, -1, 5} {
+		if got := date.AddBusinessDaysCal(n, cal); !got.Equal(date) {
+			t.Errorf("AddBusinessDaysCal(%d) = %s, want %s", n, got, date)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package dbtypes
+
+import "fmt"
+
+// RelativePhrases holds the phrases used by Date.HumanizeLocalized.
+// Past and Future are fmt formats wrapping an amount, e.g. "%s ago" and "in %s".
+// Days, Weeks, Months and Years are fmt formats of a plural amount, e.g. "%d days".
+type RelativePhrases struct {
+	Today, Yesterday, Tomorrow string
+	LastMonth, NextMonth       string
+	LastYear, NextYear         string
+	Past, Future               string
+	Days, Weeks, Months, Years string
+}
+
+var relativePhrases = map[string]RelativePhrases{
+	"en": {
+		Today:     "today",
+		Yesterday: "yesterday",
+		Tomorrow:  "tomorrow",
+		LastMonth: "last month",
+		NextMonth: "next month",
+		LastYear:  "last year",
+		NextYear:  "next year",
+		Past:      "%s ago",
+		Future:    "in %s",
+		Days:      "%d days",
+		Weeks:     "%d weeks",
+		Months:    "%d months",
+		Years:     "%d years",
+	},
+}
+
+// RegisterRelativePhrases registers the phrases used by HumanizeLocalized
+// for the locale name, alongside the names registered with RegisterLocale.
+// Registering an existing name replaces it. English is registered as "en".
+func RegisterRelativePhrases(name string, phrases RelativePhrases) {
+	localesMu.Lock()
+	relativePhrases[name] = phrases
+	localesMu.Unlock()
+}
+
+// lookupRelativePhrases returns the phrases of the named locale, falling back to English.
+func lookupRelativePhrases(name string) RelativePhrases {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	if phrases, ok := relativePhrases[name]; ok {
+		return phrases
+	}
+	return relativePhrases["en"]
+}
+
+// Humanize describes the date relative to relativeTo in English,
+// e.g. "today", "yesterday", "3 days ago", "in 2 weeks" or "last month".
+// A zero relativeTo means Today(). Zero dates return an empty string.
+//
+// Differences of up to 13 days are given in days, then in weeks below
+// 8 weeks, then in calendar months below a year and in years after that.
+// Week, month and year amounts are rounded down.
+func (date Date) Humanize(relativeTo Date) string {
+	return date.HumanizeLocalized(relativeTo, "en")
+}
+
+// HumanizeLocalized is like Humanize but uses the phrases registered for
+// locale with RegisterRelativePhrases. Unknown locales fall back to English.
+func (date Date) HumanizeLocalized(relativeTo Date, locale string) string {
+	if date.IsZero() {
+		return ""
+	}
+	if relativeTo.IsZero() {
+		relativeTo = Today()
+	}
+
+	phrases := lookupRelativePhrases(locale)
+	days := relativeTo.DaysUntil(date)
+	future := days > 0
+	days = abs(days)
+
+	var amount string
+	switch {
+	case days == 0:
+		return phrases.Today
+	case days == 1 && future:
+		return phrases.Tomorrow
+	case days == 1:
+		return phrases.Yesterday
+	case days <= 13:
+		amount = fmt.Sprintf(phrases.Days, days)
+	case days < 8*7:
+		amount = fmt.Sprintf(phrases.Weeks, days/7)
+	default:
+		period := date.Since(relativeTo)
+		months := abs(period.Years*12 + period.Months)
+		switch {
+		case months == 1 && future:
+			return phrases.NextMonth
+		case months == 1:
+			return phrases.LastMonth
+		case months < 12:
+			amount = fmt.Sprintf(phrases.Months, months)
+		case months < 24 && future:
+			return phrases.NextYear
+		case months < 24:
+			return phrases.LastYear
+		default:
+			amount = fmt.Sprintf(phrases.Years, months/12)
+		}
+	}
+
+	if future {
+		return fmt.Sprintf(phrases.Future, amount)
+	}
+	return fmt.Sprintf(phrases.Past, amount)
+}
@@ -0,0 +1,98 @@
+package dbtypes_test
+
+import (
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateHumanize(t *testing.T) {
+	ref := dbtypes.MustParseDate("2023-06-15")
+
+	tests := []struct {
+		days int
+		want string
+	}{
+		{days: 0, want: "today"},
+		{days: -1, want: "yesterday"},
+		{days: 1, want: "tomorrow"},
+		{days: -2, want: "2 days ago"},
+		{days: 2, want: "in 2 days"},
+		{days: -13, want: "13 days ago"},
+		{days: 13, want: "in 13 days"},
+		{days: -14, want: "2 weeks ago"},
+		{days: 14, want: "in 2 weeks"},
+		{days: 20, want: "in 2 weeks"},
+		{days: 21, want: "in 3 weeks"},
+		{days: -55, want: "7 weeks ago"},
+		{days: 55, want: "in 7 weeks"},
+		{days: -56, want: "last month"},
+		{days: 56, want: "next month"},
+		{days: -61, want: "2 months ago"},
+		{days: 62, want: "in 2 months"},
+		{days: -364, want: "11 months ago"},
+		{days: 365, want: "in 11 months"}, // 2024 is a leap year
+		{days: 366, want: "next year"},
+		{days: -365, want: "last year"},
+		{days: -729, want: "last year"},
+		{days: -730, want: "2 years ago"},
+		{days: 3653, want: "in 10 years"},
+	}
+
+	for _, tt := range tests {
+		date := ref.AddDays(tt.days)
+		if got := date.Humanize(ref); got != tt.want {
+			t.Errorf("%s.Humanize(%s) (%+d days) = %q, want %q", date, ref, tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestDateHumanizeZero(t *testing.T) {
+	if got := (dbtypes.Date{}).Humanize(dbtypes.Today()); got != "" {
+		t.Errorf("zero Humanize() = %q, want %q", got, "")
+	}
+	if got := dbtypes.Tomorrow().Humanize(dbtypes.Date{}); got != "tomorrow" {
+		t.Errorf("Tomorrow().Humanize(zero) = %q, want %q", got, "tomorrow")
+	}
+}
+
+func TestDateHumanizeLocalized(t *testing.T) {
+	dbtypes.RegisterRelativePhrases("fr", dbtypes.RelativePhrases{
+		Today:     "aujourd'hui",
+		Yesterday: "hier",
+		Tomorrow:  "demain",
+		LastMonth: "le mois dernier",
+		NextMonth: "le mois prochain",
+		LastYear:  "l'année dernière",
+		NextYear:  "l'année prochaine",
+		Past:      "il y a %s",
+		Future:    "dans %s",
+		Days:      "%d jours",
+		Weeks:     "%d semaines",
+		Months:    "%d mois",
+		Years:     "%d ans",
+	})
+
+	ref := dbtypes.MustParseDate("2023-06-15")
+
+	tests := []struct {
+		days   int
+		locale string
+		want   string
+	}{
+		{days: 0, locale: "fr", want: "aujourd'hui"},
+		{days: -1, locale: "fr", want: "hier"},
+		{days: 3, locale: "fr", want: "dans 3 jours"},
+		{days: -21, locale: "fr", want: "il y a 3 semaines"},
+		{days: -60, locale: "fr", want: "le mois dernier"},
+		{days: 800, locale: "fr", want: "dans 2 ans"},
+		{days: 3, locale: "xx", want: "in 3 days"},
+	}
+
+	for _, tt := range tests {
+		date := ref.AddDays(tt.days)
+		if got := date.HumanizeLocalized(ref, tt.locale); got != tt.want {
+			t.Errorf("HumanizeLocalized(%+d days, %q) = %q, want %q", tt.days, tt.locale, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package dbtypes
+
+import (
+	"iter"
+)
+
+// DatesUntil returns an iterator over every calendar day from the date
+// to end, both inclusive. Nothing is yielded if end is before the date.
+//
+//	for d := range start.DatesUntil(end) {
+//		...
+//	}
+func (date Date) DatesUntil(end Date) iter.Seq[Date] {
+	return date.DatesUntilStep(end, 1)
+}
+
+// DatesUntilStep returns an iterator over the date and every stepDays-th
+// calendar day after it, up to and including end.
+// Nothing is yielded if end is before the date or stepDays is not positive.
+// Days are added with AddDate so each yielded date is on its own calendar
+// day even across DST transitions.
+func (date Date) DatesUntilStep(end Date, stepDays int) iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if stepDays <= 0 {
+			return
+		}
+
+		for i := 0; ; i++ {
+			d := date.AddDays(i * stepDays)
+			if d.After(end) || !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// DatesBetween returns every calendar day from start to end, both inclusive.
+// It returns nil if end is before start.
+func DatesBetween(start, end Date) []Date {
+	if end.Before(start) {
+		return nil
+	}
+
+	dates := make([]Date, 0, start.DaysUntil(end)+1)
+	for d := range start.DatesUntil(end) {
+		dates = append(dates, d)
+	}
+	return dates
+}
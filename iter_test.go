@@ -0,0 +1,154 @@
+package dbtypes_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateDatesUntil(t *testing.T) {
+	tests := []struct {
+		name  string
+		start dbtypes.Date
+		end   dbtypes.Date
+		step  int
+		want  []string
+	}{
+		{
+			name:  "single day",
+			start: dbtypes.NewDate(2023, time.October, 21),
+			end:   dbtypes.NewDate(2023, time.October, 21),
+			step:  1,
+			want:  []string{"2023-10-21"},
+		},
+		{
+			name:  "across month boundary",
+			start: dbtypes.NewDate(2024, time.February, 27),
+			end:   dbtypes.NewDate(2024, time.March, 2),
+			step:  1,
+			want:  []string{"2024-02-27", "2024-02-28", "2024-02-29", "2024-03-01", "2024-03-02"},
+		},
+		{
+			name:  "end before start",
+			start: dbtypes.NewDate(2023, time.October, 21),
+			end:   dbtypes.NewDate(2023, time.October, 20),
+			step:  1,
+			want:  []string{},
+		},
+		{
+			name:  "weekly step",
+			start: dbtypes.NewDate(2023, time.October, 1),
+			end:   dbtypes.NewDate(2023, time.October, 22),
+			step:  7,
+			want:  []string{"2023-10-01", "2023-10-08", "2023-10-15", "2023-10-22"},
+		},
+		{
+			name:  "step overshooting end",
+			start: dbtypes.NewDate(2023, time.October, 1),
+			end:   dbtypes.NewDate(2023, time.October, 10),
+			step:  4,
+			want:  []string{"2023-10-01", "2023-10-05", "2023-10-09"},
+		},
+		{
+			name:  "non-positive step",
+			start: dbtypes.NewDate(2023, time.October, 1),
+			end:   dbtypes.NewDate(2023, time.October, 10),
+			step:  0,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for d := range tt.start.DatesUntilStep(tt.end, tt.step) {
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DatesUntilStep() = %v, want %v", got, tt.want)
+			}
+
+			if tt.step != 1 {
+				return
+			}
+
+			got = []string{}
+			for d := range tt.start.DatesUntil(tt.end) {
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DatesUntil() = %v, want %v", got, tt.want)
+			}
+
+			got = []string{}
+			for _, d := range dbtypes.DatesBetween(tt.start, tt.end) {
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DatesBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateDatesUntilDST(t *testing.T) {
+	tests := []struct {
+		zone string
+		want []string
+	}{
+		{
+			// DST started at midnight on 2018-11-04.
+			zone: "America/Sao_Paulo",
+			want: []string{"2018-11-02", "2018-11-03", "2018-11-04", "2018-11-05", "2018-11-06"},
+		},
+		{
+			// DST ended at 02:00 on 2023-11-05.
+			zone: "America/New_York",
+			want: []string{"2023-11-04", "2023-11-05", "2023-11-06"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatalf("Failed to load location: %v", err)
+			}
+
+			start, err := dbtypes.ParseDate(tt.want[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			end, err := dbtypes.ParseDate(tt.want[len(tt.want)-1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			start = dbtypes.NewDateIn(start.Year(), time.Month(start.Month()), start.Day(), loc)
+			end = dbtypes.NewDateIn(end.Year(), time.Month(end.Month()), end.Day(), loc)
+
+			got := []string{}
+			for d := range start.DatesUntil(end) {
+				got = append(got, d.String())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DatesUntil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateDatesUntilBreak(t *testing.T) {
+	start := dbtypes.NewDate(2023, time.January, 1)
+	count := 0
+	for range start.DatesUntil(start.AddYears(100)) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("iterated %d times, want 3", count)
+	}
+}
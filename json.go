@@ -5,7 +5,13 @@ import (
 	"database/sql/driver"
 	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // JSON implements the database/sql/driver Scanner and Valuer interfaces,
@@ -16,22 +22,216 @@ func init() {
 	// Register JSON type for gob encoding/decoding
 	gob.Register(JSON{})
 	gob.Register(&Date{})
+	gob.Register(&NullDate{})
+	// Types of decoded JSON values stored in the map.
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+	gob.Register(json.Number(""))
 }
 
-// Scan scans a value into JSON, implements sql.Scanner interface
+// jsonUseNumber controls whether JSON numbers are decoded as json.Number.
+var jsonUseNumber bool
+
+// JSONUseNumber configures JSON.Scan to decode numbers as json.Number instead
+// of float64, so that integers above 2^53 like 9007199254740993 keep their
+// precision and are written back unchanged by Value.
+//
+// It is disabled by default for backwards compatibility. When enabling it,
+// replace type assertions like j["count"].(float64) with GetInt64 or with
+// n.(json.Number).Int64() and .Float64().
+// This should be called once at program startup.
+func JSONUseNumber(enable bool) {
+	jsonUseNumber = enable
+}
+
+// decodeJSONObject decodes data holding a single JSON object,
+// honoring JSONUseNumber.
+func decodeJSONObject(data []byte) (map[string]interface{}, error) {
+	var m map[string]interface{}
+	if err := decodeJSON(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// decodeJSON decodes data holding a single JSON value into v,
+// honoring JSONUseNumber.
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if jsonUseNumber {
+		dec.UseNumber()
+	}
+
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("invalid character after top-level JSON value")
+	}
+	return nil
+}
+
+// Scan scans a value into JSON, implements sql.Scanner interface.
+// It accepts JSON text as []byte or string, NULL and already decoded maps.
+// NULL, empty text and the JSON literal null set j to a nil map.
+// Other types result in a *ScanTypeError.
 func (j *JSON) Scan(value interface{}) error {
-	if err := json.Unmarshal(value.([]byte), &j); err != nil {
+	switch v := value.(type) {
+	case nil:
+		*j = nil
+		return nil
+	case []byte:
+		return j.scanText(v)
+	case string:
+		return j.scanText([]byte(v))
+	case map[string]interface{}:
+		if err := validateJSONValue(v); err != nil {
+			return err
+		}
+		*j = JSON(v)
+		return nil
+	case JSON:
+		if err := validateJSONValue(map[string]interface{}(v)); err != nil {
+			return err
+		}
+		*j = v
+		return nil
+	}
+	return &ScanTypeError{Value: value, Target: "JSON"}
+}
+
+func (j *JSON) scanText(data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		*j = nil
+		return nil
+	}
+
+	if err := checkJSONLimits(data); err != nil {
 		return err
 	}
+	m, err := decodeJSONObject(data)
+	if err != nil {
+		return err
+	}
+	if err := validateJSON(data); err != nil {
+		return err
+	}
+	*j = JSON(m)
 	return nil
 }
 
-// Value returns the JSON value, implements driver.Valuer interface
+// FormScan implements the FormScanner interface (see Date.FormScan).
+// value may be a JSON object as a string, []byte or []string (the first
+// element is used), or url.Values and map[string][]string whose keys become
+// members: single values are strings and repeated ones arrays of strings.
+// Empty strings are skipped.
+func (j *JSON) FormScan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case []string:
+		if len(v) > 0 {
+			s = v[0]
+		}
+	case url.Values:
+		*j = formValuesJSON(v)
+		return nil
+	case map[string][]string:
+		*j = formValuesJSON(v)
+		return nil
+	default:
+		return fmt.Errorf("invalid JSON. Expected value as a string")
+	}
+
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	if err := checkJSONLimits([]byte(s)); err != nil {
+		return err
+	}
+
+	m, err := decodeJSONObject([]byte(s))
+	if err != nil {
+		return fmt.Errorf("invalid JSON %q: %w", truncateInput(s), err)
+	}
+	if err := validateJSON([]byte(s)); err != nil {
+		return err
+	}
+	*j = JSON(m)
+	return nil
+}
+
+// formValuesJSON returns the form values as a JSON object, see FormScan.
+func formValuesJSON(values map[string][]string) JSON {
+	j := make(JSON, len(values))
+	for key, vals := range values {
+		switch len(vals) {
+		case 0:
+		case 1:
+			j[key] = vals[0]
+		default:
+			arr := make([]interface{}, len(vals))
+			for i, v := range vals {
+				arr[i] = v
+			}
+			j[key] = arr
+		}
+	}
+	return j
+}
+
+// nilJSONAsNull controls whether JSON.Value stores nil maps as NULL.
+var nilJSONAsNull = true
+
+// NilJSONAsNull configures whether JSON.Value returns NULL (nil) for nil maps.
+// It is enabled by default, so a nil map is SQL NULL while an empty map is
+// stored as "{}". Disable it to store nil maps as the JSON literal "null"
+// as older versions did.
+// This should be called once at program startup, before any values are written.
+func NilJSONAsNull(enable bool) {
+	nilJSONAsNull = enable
+}
+
+// Value returns the JSON value, implements driver.Valuer interface.
+// Nil maps are NULL (see NilJSONAsNull) and empty maps are "{}".
+// See CanonicalJSONValues to write canonical JSON.
 func (j JSON) Value() (driver.Value, error) {
+	if j == nil && nilJSONAsNull {
+		return nil, nil
+	}
+	if canonicalJSONValues {
+		data, err := j.CanonicalJSON()
+		return string(data), err
+	}
+
 	valueString, err := json.Marshal(j)
 	return string(valueString), err
 }
 
+// String returns j as compact JSON with sorted keys, or null for nil maps.
+// Values that cannot be marshaled fall back to Go's map formatting.
+func (j JSON) String() string {
+	data, err := j.Compact()
+	if err != nil {
+		return fmt.Sprint(map[string]interface{}(j))
+	}
+	return string(data)
+}
+
+// Compact returns j as compact JSON with sorted keys, or null for nil maps.
+func (j JSON) Compact() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(j))
+}
+
+// Indent returns j as indented JSON with sorted keys like json.MarshalIndent,
+// or null for nil maps.
+func (j JSON) Indent(prefix, indent string) ([]byte, error) {
+	return json.MarshalIndent(map[string]interface{}(j), prefix, indent)
+}
+
 // Custom function used by the gorm ORM if used.
 func (j JSON) GormDataType() string {
 	return "jsonb"
@@ -41,7 +241,8 @@ func (j JSON) GormDataType() string {
 func (j JSON) GobEncode() ([]byte, error) {
 	buffer := new(bytes.Buffer)
 	encoder := gob.NewEncoder(buffer)
-	err := encoder.Encode(j)
+	// Encode the underlying map, encoding j itself would call GobEncode again.
+	err := encoder.Encode(map[string]interface{}(j))
 	if err != nil {
 		return nil, fmt.Errorf("error encoding JSON: %v", err)
 	}
@@ -50,11 +251,105 @@ func (j JSON) GobEncode() ([]byte, error) {
 
 // GobDecode decodes the gob-encoded data into a JSON value.
 func (j *JSON) GobDecode(data []byte) error {
+	if err := checkJSONSize(len(data)); err != nil {
+		return err
+	}
 	buffer := bytes.NewBuffer(data)
 	decoder := gob.NewDecoder(buffer)
-	err := decoder.Decode(&j)
+	var m map[string]interface{}
+	err := decoder.Decode(&m)
 	if err != nil {
 		return fmt.Errorf("error decoding JSON: %v", err)
 	}
+	*j = JSON(m)
 	return nil
 }
+
+var (
+	// ErrKeyNotFound is returned when a JSON object has no value for a key.
+	ErrKeyNotFound = errors.New("key not found")
+
+	// ErrNotInteger is returned when a JSON value is not a whole number.
+	ErrNotInteger = errors.New("value is not an integer")
+)
+
+// GetInt64 returns the value at path (see Get) as an int64. It understands
+// float64, json.Number (see JSONUseNumber) and Go integer types.
+// Unlike the other getters, it reports why the value could not be returned:
+// the error wraps ErrKeyNotFound if the path is missing, ErrNotInteger if the
+// value is not a whole number, or strconv.ErrRange if it does not fit in an int64.
+func (j JSON) GetInt64(path string) (int64, error) {
+	v, ok := j.Get(path)
+	if !ok {
+		return 0, fmt.Errorf("%q: %w", path, ErrKeyNotFound)
+	}
+
+	n, err := toInt64(v)
+	if err != nil {
+		return 0, fmt.Errorf("%q: %w", path, err)
+	}
+	return n, nil
+}
+
+// toInt64 converts a decoded JSON number to an int64.
+func toInt64(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		if err == nil {
+			return i, nil
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, strconv.ErrRange
+		}
+
+		// Integers in exponent form like 1e3.
+		f, err := n.Float64()
+		if err != nil {
+			return 0, ErrNotInteger
+		}
+		return floatToInt64(f)
+	case float64:
+		return floatToInt64(n)
+	case float32:
+		return floatToInt64(float64(n))
+	case int:
+		return int64(n), nil
+	case int8:
+		return int64(n), nil
+	case int16:
+		return int64(n), nil
+	case int32:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint:
+		return uintToInt64(uint64(n))
+	case uint8:
+		return int64(n), nil
+	case uint16:
+		return int64(n), nil
+	case uint32:
+		return int64(n), nil
+	case uint64:
+		return uintToInt64(n)
+	}
+	return 0, ErrNotInteger
+}
+
+func floatToInt64(f float64) (int64, error) {
+	if f != math.Trunc(f) || math.IsNaN(f) {
+		return 0, ErrNotInteger
+	}
+	if f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, strconv.ErrRange
+	}
+	return int64(f), nil
+}
+
+func uintToInt64(n uint64) (int64, error) {
+	if n > math.MaxInt64 {
+		return 0, strconv.ErrRange
+	}
+	return int64(n), nil
+}
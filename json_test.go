@@ -0,0 +1,311 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    dbtypes.JSON
+		wantErr bool
+	}{
+		{name: "bytes", value: []byte(`{"name":"doc","age":42}`), want: dbtypes.JSON{"name": "doc", "age": 42.0}},
+		{name: "string", value: `{"tags":["a","b"]}`, want: dbtypes.JSON{"tags": []interface{}{"a", "b"}}},
+		{name: "empty object", value: `{}`, want: dbtypes.JSON{}},
+		{name: "nil", value: nil, want: nil},
+		{name: "empty string", value: "", want: nil},
+		{name: "empty bytes", value: []byte{}, want: nil},
+		{name: "null bytes", value: []byte("null"), want: nil},
+		{name: "null string", value: "null", want: nil},
+		{name: "map", value: map[string]interface{}{"ok": true}, want: dbtypes.JSON{"ok": true}},
+		{name: "JSON", value: dbtypes.JSON{"ok": true}, want: dbtypes.JSON{"ok": true}},
+		{name: "array", value: `[1,2]`, wantErr: true},
+		{name: "malformed", value: []byte(`{"name":`), wantErr: true},
+		{name: "int", value: int64(42), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dbtypes.JSON{"previous": "value"}
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan(%v) = %#v, want %#v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONScanTypeError(t *testing.T) {
+	var j dbtypes.JSON
+	err := j.Scan(3.14)
+
+	var typeErr *dbtypes.ScanTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("Scan(3.14) error = %v, want *ScanTypeError", err)
+	}
+	if typeErr.Target != "JSON" || typeErr.Value != 3.14 {
+		t.Errorf("Scan(3.14) error = %+v", typeErr)
+	}
+	if want := "cannot scan float64 into JSON"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	defer dbtypes.NilJSONAsNull(true)
+
+	tests := []struct {
+		name      string
+		value     dbtypes.JSON
+		nilAsNull bool
+		want      driver.Value
+	}{
+		{name: "nil", value: nil, nilAsNull: true, want: nil},
+		{name: "nil compat", value: nil, nilAsNull: false, want: "null"},
+		{name: "empty", value: dbtypes.JSON{}, nilAsNull: true, want: "{}"},
+		{name: "populated", value: dbtypes.JSON{"ok": true}, nilAsNull: true, want: `{"ok":true}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbtypes.NilJSONAsNull(tt.nilAsNull)
+			db := openFakeDB(t)
+
+			if _, err := db.Exec("INSERT INTO t VALUES (?)", tt.value); err != nil {
+				t.Fatalf("Exec() returned error: %v", err)
+			}
+			if got := fakeRowValues(t)[0][0]; got != tt.want {
+				t.Errorf("stored value = %#v, want %#v", got, tt.want)
+			}
+
+			got := dbtypes.JSON{"previous": "value"}
+			if err := db.QueryRow("SELECT * FROM t").Scan(&got); err != nil {
+				t.Fatalf("Scan() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.value) {
+				t.Errorf("round trip = %#v, want %#v", got, tt.value)
+			}
+		})
+	}
+}
+
+func TestJSONUseNumberRoundTrip(t *testing.T) {
+	dbtypes.JSONUseNumber(true)
+	defer dbtypes.JSONUseNumber(false)
+
+	const doc = `{"id":9007199254740993,"max":9223372036854775807,"nested":{"id":-9007199254740993},"price":19.99}`
+
+	var j dbtypes.JSON
+	if err := j.Scan([]byte(doc)); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if got, ok := j["id"].(json.Number); !ok || got != "9007199254740993" {
+		t.Errorf(`j["id"] = %#v, want json.Number("9007199254740993")`, j["id"])
+	}
+
+	v, err := j.Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if v != doc {
+		t.Errorf("Value() = %s, want %s", v, doc)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(j); err != nil {
+		t.Fatalf("gob Encode() returned error: %v", err)
+	}
+	var decoded dbtypes.JSON
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatalf("gob Decode() returned error: %v", err)
+	}
+	if decoded["id"] != json.Number("9007199254740993") {
+		t.Errorf(`gob round trip j["id"] = %#v`, decoded["id"])
+	}
+}
+
+func TestJSONScanFloatByDefault(t *testing.T) {
+	var j dbtypes.JSON
+	if err := j.Scan(`{"count":3}`); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if _, ok := j["count"].(float64); !ok {
+		t.Errorf(`j["count"] = %#v, want float64`, j["count"])
+	}
+}
+
+func TestJSONGetInt64(t *testing.T) {
+	j := dbtypes.JSON{
+		"number":   json.Number("9007199254740993"),
+		"exponent": json.Number("1e3"),
+		"float":    42.0,
+		"int":      7,
+		"uint64":   uint64(math.MaxUint64),
+		"fraction": 1.5,
+		"numfrac":  json.Number("1.5"),
+		"overflow": json.Number("9223372036854775808"),
+		"big":      1e19,
+		"string":   "42",
+		"null":     nil,
+	}
+
+	tests := []struct {
+		key     string
+		want    int64
+		wantErr error
+	}{
+		{key: "number", want: 9007199254740993},
+		{key: "exponent", want: 1000},
+		{key: "float", want: 42},
+		{key: "int", want: 7},
+		{key: "uint64", wantErr: strconv.ErrRange},
+		{key: "fraction", wantErr: dbtypes.ErrNotInteger},
+		{key: "numfrac", wantErr: dbtypes.ErrNotInteger},
+		{key: "overflow", wantErr: strconv.ErrRange},
+		{key: "big", wantErr: strconv.ErrRange},
+		{key: "string", wantErr: dbtypes.ErrNotInteger},
+		{key: "null", wantErr: dbtypes.ErrNotInteger},
+		{key: "missing", wantErr: dbtypes.ErrKeyNotFound},
+	}
+
+	for _, tt := range tests {
+		got, err := j.GetInt64(tt.key)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetInt64(%q) error = %v, want %v", tt.key, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("GetInt64(%q) = %d, %v, want %d", tt.key, got, err, tt.want)
+		}
+	}
+}
+
+func TestJSONFormScan(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  dbtypes.JSON
+	}{
+		{"string", `{"name":"Ann","tags":["a"]}`, dbtypes.JSON{"name": "Ann", "tags": []interface{}{"a"}}},
+		{"bytes", []byte(`{"n":1}`), dbtypes.JSON{"n": 1.0}},
+		{"string slice", []string{`{"n":1}`, `{"n":2}`}, dbtypes.JSON{"n": 1.0}},
+		{"url.Values", url.Values{"name": {"Ann"}, "tags": {"a", "b"}, "none": {}}, dbtypes.JSON{"name": "Ann", "tags": []interface{}{"a", "b"}}},
+		{"map", map[string][]string{"page": {"2"}}, dbtypes.JSON{"page": "2"}},
+		{"empty string", "", dbtypes.JSON{"previous": true}},
+		{"blank string", "  ", dbtypes.JSON{"previous": true}},
+		{"empty slice", []string{}, dbtypes.JSON{"previous": true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := dbtypes.JSON{"previous": true}
+			if err := j.FormScan(tt.value); err != nil {
+				t.Fatalf("FormScan() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(j, tt.want) {
+				t.Errorf("FormScan() = %v, want %v", j, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONFormScanErrors(t *testing.T) {
+	long := `{"description":"` + strings.Repeat("x", 200) + `"`
+
+	tests := []struct {
+		value   interface{}
+		snippet string
+	}{
+		{`{"name":}`, `"{\"name\":}"`},
+		{`[1,2]`, `"[1,2]"`},
+		{long, `"` + strings.ReplaceAll(long[:64], `"`, `\"`) + `..."`},
+		{42, ""},
+	}
+
+	for _, tt := range tests {
+		var j dbtypes.JSON
+		err := j.FormScan(tt.value)
+		if err == nil {
+			t.Errorf("FormScan(%v) returned no error", tt.value)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.snippet) {
+			t.Errorf("FormScan() error = %q, want it to contain %s", err, tt.snippet)
+		}
+		if len(err.Error()) > 200 {
+			t.Errorf("FormScan() error is %d bytes long", len(err.Error()))
+		}
+	}
+}
+
+func TestJSONFormatting(t *testing.T) {
+	j := mustJSON(t, `{"zeta": [1, 2.5, {"b": null, "a": "x"}], "alpha": {}, "mid": "<tag>"}`)
+
+	wantCompact := `{"alpha":{},"mid":"\u003ctag\u003e","zeta":[1,2.5,{"a":"x","b":null}]}`
+	for i := 0; i < 10; i++ {
+		got, err := j.Compact()
+		if err != nil {
+			t.Fatalf("Compact() returned error: %v", err)
+		}
+		if string(got) != wantCompact {
+			t.Fatalf("Compact() = %s, want %s", got, wantCompact)
+		}
+		if s := j.String(); s != wantCompact {
+			t.Fatalf("String() = %s, want %s", s, wantCompact)
+		}
+	}
+
+	wantIndent := "{\n>  \"alpha\": {},\n>  \"mid\": \"\\u003ctag\\u003e\",\n>  \"zeta\": [\n>    1,\n>    2.5,\n>    {\n>      \"a\": \"x\",\n>      \"b\": null\n>    }\n>  ]\n>}"
+	got, err := j.Indent(">", "  ")
+	if err != nil {
+		t.Fatalf("Indent() returned error: %v", err)
+	}
+	if string(got) != wantIndent {
+		t.Errorf("Indent() = %s, want %s", got, wantIndent)
+	}
+
+	if got := fmt.Sprintf("%v", dbtypes.JSON{"a": 1}); got != `{"a":1}` {
+		t.Errorf("Sprintf(%%v) = %s, want {\"a\":1}", got)
+	}
+}
+
+func TestJSONFormattingNil(t *testing.T) {
+	var j dbtypes.JSON
+	if s := j.String(); s != "null" {
+		t.Errorf("nil String() = %s, want null", s)
+	}
+	if got, err := j.Indent("", "  "); err != nil || string(got) != "null" {
+		t.Errorf("nil Indent() = %s, %v, want null", got, err)
+	}
+
+	bad := dbtypes.JSON{"f": func() {}}
+	if _, err := bad.Compact(); err == nil {
+		t.Errorf("Compact() of a func returned no error")
+	}
+	if s := bad.String(); !strings.HasPrefix(s, "map[f:") {
+		t.Errorf("String() of a func = %s, want Go map formatting", s)
+	}
+}
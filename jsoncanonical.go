@@ -0,0 +1,218 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// canonicalJSONValues controls whether JSON.Value writes canonical JSON.
+var canonicalJSONValues bool
+
+// CanonicalJSONValues configures whether JSON.Value writes the canonical form
+// produced by CanonicalJSON instead of the encoding/json output, so that
+// equal documents are always stored as identical bytes, e.g. for content
+// hashes. It is disabled by default.
+// This should be called once at program startup.
+func CanonicalJSONValues(enable bool) {
+	canonicalJSONValues = enable
+}
+
+// CanonicalJSON returns j in the canonical form of RFC 8785 (JSON
+// Canonicalization Scheme): object keys sorted by their UTF-16 code units at
+// every level, numbers formatted like ECMAScript's Number.prototype.toString,
+// strings with minimal escaping and no insignificant whitespace. Equal
+// documents always produce identical bytes. Nil objects return null.
+//
+// Numbers are formatted as IEEE 754 doubles as RFC 8785 requires, except
+// json.Number values (see JSONUseNumber), which are written verbatim so that
+// integers beyond 2^53 keep their precision; 1.0 and 1 are then not
+// canonicalized to the same bytes. Values that are not decoded JSON, like
+// structs or Dates, are marshaled with encoding/json first. NaN, infinities
+// and malformed json.Number values result in an error.
+func (j JSON) CanonicalJSON() ([]byte, error) {
+	if j == nil {
+		return []byte("null"), nil
+	}
+	return appendCanonical(nil, map[string]interface{}(j))
+}
+
+// appendCanonical appends the canonical form of v to b, see CanonicalJSON.
+func appendCanonical(b []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...), nil
+	case bool:
+		return strconv.AppendBool(b, v), nil
+	case string:
+		return appendCanonicalString(b, v), nil
+	case map[string]interface{}:
+		return appendCanonicalObject(b, v)
+	case JSON:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		return appendCanonicalObject(b, v)
+	case []interface{}:
+		if v == nil {
+			return append(b, "null"...), nil
+		}
+		b = append(b, '[')
+		for i, elem := range v {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			var err error
+			if b, err = appendCanonical(b, elem); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, ']'), nil
+	case json.Number:
+		if !isJSONNumber(string(v)) {
+			return nil, fmt.Errorf("canonical JSON: invalid number %q", string(v))
+		}
+		return append(b, v...), nil
+	}
+
+	if f, ok := toFloat64(v); ok {
+		return appendCanonicalNumber(b, f)
+	}
+
+	// Re-decode other values as generic JSON.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return appendCanonical(b, decoded)
+}
+
+// isJSONNumber reports whether s is a JSON number literal.
+func isJSONNumber(s string) bool {
+	if s == "" || (s[0] != '-' && !isDigits(s[:1])) || !isDigits(s[len(s)-1:]) {
+		return false
+	}
+	return json.Valid([]byte(s))
+}
+
+// appendCanonicalObject appends obj with its keys in UTF-16 order.
+func appendCanonicalObject(b []byte, obj map[string]interface{}) ([]byte, error) {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, compareUTF16)
+
+	b = append(b, '{')
+	for i, key := range keys {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendCanonicalString(b, key)
+		b = append(b, ':')
+
+		var err error
+		if b, err = appendCanonical(b, obj[key]); err != nil {
+			return nil, err
+		}
+	}
+	return append(b, '}'), nil
+}
+
+// compareUTF16 compares a and b by their UTF-16 code units as RFC 8785
+// requires. It differs from byte order for characters above U+FFFF, whose
+// surrogates sort before U+E000 to U+FFFF.
+func compareUTF16(a, b string) int {
+	return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+}
+
+// appendCanonicalString appends s as a JSON string, escaping only quotes,
+// backslashes and control characters. Invalid UTF-8 is replaced by U+FFFD.
+func appendCanonicalString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	b = append(b, '"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b = append(b, '\\', byte(r))
+		case r == '\b':
+			b = append(b, '\\', 'b')
+		case r == '\f':
+			b = append(b, '\\', 'f')
+		case r == '\n':
+			b = append(b, '\\', 'n')
+		case r == '\r':
+			b = append(b, '\\', 'r')
+		case r == '\t':
+			b = append(b, '\\', 't')
+		case r < 0x20:
+			b = append(b, '\\', 'u', '0', '0', hex[r>>4], hex[r&0xF])
+		default:
+			b = utf8.AppendRune(b, r)
+		}
+	}
+	return append(b, '"')
+}
+
+// appendCanonicalNumber appends f formatted like ECMAScript's
+// Number.prototype.toString: the shortest digits that round trip, in plain
+// notation for magnitudes from 1e-6 up to 1e21 and exponential otherwise.
+func appendCanonicalNumber(b []byte, f float64) ([]byte, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("canonical JSON: unsupported number %v", f)
+	}
+	if f == 0 {
+		return append(b, '0'), nil
+	}
+	if f < 0 {
+		b = append(b, '-')
+		f = -f
+	}
+
+	// Shortest digits and exponent, e.g. "1.2345e+06".
+	mantissa, exp, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	e, _ := strconv.Atoi(exp)
+
+	// The value is 0.digits * 10^n.
+	k, n := len(digits), e+1
+	switch {
+	case k <= n && n <= 21:
+		b = append(b, digits...)
+		for i := k; i < n; i++ {
+			b = append(b, '0')
+		}
+	case 0 < n && n <= 21:
+		b = append(b, digits[:n]...)
+		b = append(b, '.')
+		b = append(b, digits[n:]...)
+	case -6 < n && n <= 0:
+		b = append(b, '0', '.')
+		for i := n; i < 0; i++ {
+			b = append(b, '0')
+		}
+		b = append(b, digits...)
+	default:
+		b = append(b, digits[0])
+		if k > 1 {
+			b = append(b, '.')
+			b = append(b, digits[1:]...)
+		}
+		b = append(b, 'e')
+		if n-1 >= 0 {
+			b = append(b, '+')
+		}
+		b = strconv.AppendInt(b, int64(n-1), 10)
+	}
+	return b, nil
+}
@@ -0,0 +1,132 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// canonicalFixture is the expected canonical form of canonicalInput.
+const (
+	canonicalInput = `{
+		"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000001, 1e-7, 100, -0, 1e21, 123456789012345680000],
+		"string": "€$\u000F\u000aA'B\"\\\\\"\/<>&",
+		"literals": [null, true, false],
+		"€": "Euro Sign",
+		"\r": "Carriage Return",
+		"😀": "Emoji: Grinning Face",
+		"\ufb33": "Hebrew Letter Dalet With Dagesh",
+		"1": "One",
+		"nested": {"z": {}, "a": []}
+	}`
+	canonicalFixture = `{"\r":"Carriage Return","1":"One","literals":[null,true,false],"nested":{"a":[],"z":{}},` +
+		`"numbers":[333333333.3333333,1e+30,4.5,0.002,0.000001,1e-7,100,0,1e+21,123456789012345680000],` +
+		`"string":"€$\u000f\nA'B\"\\\\\"/<>&","€":"Euro Sign","😀":"Emoji: Grinning Face","` + "\ufb33" + `":"Hebrew Letter Dalet With Dagesh"}`
+)
+
+func TestJSONCanonicalJSON(t *testing.T) {
+	j := mustJSON(t, canonicalInput)
+	for i := 0; i < 10; i++ {
+		got, err := j.CanonicalJSON()
+		if err != nil {
+			t.Fatalf("CanonicalJSON() returned error: %v", err)
+		}
+		if string(got) != canonicalFixture {
+			t.Fatalf("CanonicalJSON() = %s, want %s", got, canonicalFixture)
+		}
+	}
+}
+
+func TestJSONCanonicalJSONUseNumber(t *testing.T) {
+	dbtypes.JSONUseNumber(true)
+	defer dbtypes.JSONUseNumber(false)
+
+	j := mustJSON(t, `{"id": 9007199254740993, "b": [1.50, -0]}`)
+	want := `{"b":[1.50,-0],"id":9007199254740993}`
+	got, err := j.CanonicalJSON()
+	if err != nil {
+		t.Fatalf("CanonicalJSON() returned error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("CanonicalJSON() = %s, want %s", got, want)
+	}
+
+	dbtypes.CanonicalJSONValues(true)
+	defer dbtypes.CanonicalJSONValues(false)
+
+	value, err := j.Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	var scanned dbtypes.JSON
+	if err := scanned.Scan(value); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if id, err := scanned.GetInt64("id"); err != nil || id != 9007199254740993 {
+		t.Errorf("round trip id = %d, %v, want 9007199254740993", id, err)
+	}
+}
+
+func TestJSONCanonicalJSONValues(t *testing.T) {
+	tests := []struct {
+		name string
+		j    dbtypes.JSON
+		want string
+	}{
+		{name: "nil", j: nil, want: "null"},
+		{name: "empty", j: dbtypes.JSON{}, want: "{}"},
+		{name: "go integers", j: dbtypes.JSON{"b": 1, "a": int64(-2), "c": uint8(3)}, want: `{"a":-2,"b":1,"c":3}`},
+		{name: "floats", j: dbtypes.JSON{"a": 1.5, "b": float32(0.5), "c": 1e-7, "d": 5e-324}, want: `{"a":1.5,"b":0.5,"c":1e-7,"d":5e-324}`},
+		{name: "json number", j: dbtypes.JSON{"n": json.Number("1.0e2")}, want: `{"n":1.0e2}`},
+		{name: "nested JSON", j: dbtypes.JSON{"x": dbtypes.JSON{"b": true, "a": nil}}, want: `{"x":{"a":null,"b":true}}`},
+		{name: "struct", j: dbtypes.JSON{"s": struct {
+			Z string `json:"z"`
+			A int    `json:"a"`
+		}{"<", 2}}, want: `{"s":{"a":2,"z":"<"}}`},
+		{name: "invalid utf8", j: dbtypes.JSON{"s": "a\xffb"}, want: `{"s":"a` + "�" + `b"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.j.CanonicalJSON()
+			if err != nil {
+				t.Fatalf("CanonicalJSON() returned error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("CanonicalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONCanonicalJSONInvalidNumber(t *testing.T) {
+	for _, n := range []interface{}{math.NaN(), math.Inf(1), math.Inf(-1), json.Number(""), json.Number("1 "), json.Number("true"), json.Number("0x10")} {
+		if _, err := (dbtypes.JSON{"n": n}).CanonicalJSON(); err == nil {
+			t.Errorf("CanonicalJSON(%#v) returned no error", n)
+		}
+	}
+}
+
+func TestCanonicalJSONValues(t *testing.T) {
+	dbtypes.CanonicalJSONValues(true)
+	defer dbtypes.CanonicalJSONValues(false)
+
+	j := dbtypes.JSON{"html": "<b>", "n": 1e21, "a": []interface{}{2.50}}
+	want := `{"a":[2.5],"html":"<b>","n":1e+21}`
+	for i := 0; i < 10; i++ {
+		got, err := j.Value()
+		if err != nil {
+			t.Fatalf("Value() returned error: %v", err)
+		}
+		if got != want {
+			t.Fatalf("Value() = %v, want %v", got, want)
+		}
+	}
+
+	var empty dbtypes.JSON
+	if got, err := empty.Value(); err != nil || got != nil {
+		t.Errorf("nil Value() = %v, %v, want nil, nil", got, err)
+	}
+}
@@ -0,0 +1,87 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Clone returns a deep copy of j that shares no objects or arrays with j,
+// so it can be modified or handed to another goroutine independently.
+// Cloning a nil object returns nil.
+func (j JSON) Clone() JSON {
+	if j == nil {
+		return nil
+	}
+	return JSON(cloneObject(j))
+}
+
+// cloneObject returns a deep copy of obj, which is never nil.
+func cloneObject(obj map[string]interface{}) map[string]interface{} {
+	clone := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		clone[key] = deepCopy(value)
+	}
+	return clone
+}
+
+// deepCopy returns a deep copy of v. Objects and arrays as decoded by
+// encoding/json are copied recursively, as are other maps and slices set
+// by callers, e.g. []string. Strings, booleans and numbers including
+// json.Number are immutable and returned as is, as are other values
+// like pointers and structs.
+func deepCopy(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil, string, bool, float64, json.Number:
+		return v
+	case map[string]interface{}:
+		if v == nil {
+			return v
+		}
+		return cloneObject(v)
+	case JSON:
+		return v.Clone()
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		clone := make([]interface{}, len(v))
+		for i, elem := range v {
+			clone[i] = deepCopy(elem)
+		}
+		return clone
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return v
+		}
+		clone := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			clone.SetMapIndex(iter.Key(), deepCopyValue(iter.Value(), rv.Type().Elem()))
+		}
+		return clone.Interface()
+	case reflect.Slice:
+		if rv.IsNil() {
+			return v
+		}
+		clone := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			clone.Index(i).Set(deepCopyValue(rv.Index(i), rv.Type().Elem()))
+		}
+		return clone.Interface()
+	}
+	return v
+}
+
+// deepCopyValue returns a deep copy of the map or slice element rv
+// as a value assignable to typ.
+func deepCopyValue(rv reflect.Value, typ reflect.Type) reflect.Value {
+	copied := reflect.ValueOf(deepCopy(rv.Interface()))
+	if !copied.IsValid() {
+		return reflect.Zero(typ)
+	}
+	return copied
+}
@@ -0,0 +1,70 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONClone(t *testing.T) {
+	j := mustJSON(t, `{"title":"x","n":1,"ok":true,"none":null,"items":[{"tags":["a","b"]},[1,[2]]],"meta":{"author":{"name":"Ann"}}}`)
+	j["strings"] = []string{"p", "q"}
+	j["counts"] = map[string]int{"a": 1}
+	j["number"] = json.Number("9007199254740993")
+	j["typed"] = dbtypes.JSON{"k": []interface{}{"v"}}
+	want := jsonString(t, j)
+
+	clone := j.Clone()
+	if !reflect.DeepEqual(clone, j) {
+		t.Fatalf("Clone() = %v, want %v", clone, j)
+	}
+
+	clone["items"].([]interface{})[0].(map[string]interface{})["tags"].([]interface{})[0] = "changed"
+	clone["items"].([]interface{})[1].([]interface{})[1].([]interface{})[0] = 99
+	clone["items"] = append(clone["items"].([]interface{}), "appended")
+	clone["meta"].(map[string]interface{})["author"].(map[string]interface{})["name"] = "Bob"
+	clone["strings"].([]string)[0] = "changed"
+	clone["counts"].(map[string]int)["a"] = 2
+	clone["typed"].(dbtypes.JSON)["k"].([]interface{})[0] = "changed"
+	clone["title"] = "y"
+
+	if got := jsonString(t, j); got != want {
+		t.Errorf("modifying the clone changed the original to %s, want %s", got, want)
+	}
+}
+
+func TestJSONCloneNil(t *testing.T) {
+	var j dbtypes.JSON
+	if got := j.Clone(); got != nil {
+		t.Errorf("nil Clone() = %v, want nil", got)
+	}
+
+	if got := (dbtypes.JSON{}).Clone(); got == nil || len(got) != 0 {
+		t.Errorf("empty Clone() = %#v, want empty object", got)
+	}
+}
+
+func TestJSONCloneConcurrent(t *testing.T) {
+	j := mustJSON(t, `{"items":[{"qty":1}],"meta":{"tags":["a"]}}`)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		clone := j.Clone()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				clone["items"].([]interface{})[0].(map[string]interface{})["qty"] = float64(n)
+				clone["meta"].(map[string]interface{})["tags"].([]interface{})[0] = "b"
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := jsonString(t, j); got != `{"items":[{"qty":1}],"meta":{"tags":["a"]}}` {
+		t.Errorf("original = %s after modifying clones", got)
+	}
+}
@@ -0,0 +1,173 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operations of a Change.
+const (
+	ChangeAdd    = "add"
+	ChangeRemove = "remove"
+	ChangeChange = "change"
+)
+
+// Change is a difference between two JSON documents, see JSONDiff.
+type Change struct {
+	Path string      // Path of the value (see JSON.Get)
+	Op   string      // ChangeAdd, ChangeRemove or ChangeChange
+	Old  interface{} // Previous value, nil for additions
+	New  interface{} // New value, nil for removals
+}
+
+// Changes is a list of changes returned by JSONDiff.
+type Changes []Change
+
+// JSONDiff returns the changes that turn a into b, e.g. for audit logs.
+// Objects are compared member by member in key order and arrays element by
+// element, with elements removed from or added at the end; other values that
+// differ are changed. Values are compared like JSON.Equal, so 1 and 1.0 are
+// equal. Old and New are copies. Nil objects are treated as empty objects.
+func JSONDiff(a, b JSON) Changes {
+	var changes Changes
+	for _, c := range diffJSON(a, b) {
+		changes = append(changes, Change{Path: formatPath(c.path), Op: c.op, Old: c.old, New: c.new})
+	}
+	return changes
+}
+
+// pathChange is a Change whose path is kept as segments,
+// to be formatted as a path (see Get) or a JSON pointer.
+type pathChange struct {
+	path     []pathSegment
+	op       string
+	old, new interface{}
+}
+
+// diffJSON returns the changes turning a into b, see JSONDiff.
+// Removed array elements are listed from the end so that they can be
+// applied in order.
+func diffJSON(a, b JSON) []pathChange {
+	var changes []pathChange
+	diffObjectChanges(&changes, nil, a, b)
+	return changes
+}
+
+// diffObjectChanges appends the changes turning the objects a at path into b.
+func diffObjectChanges(changes *[]pathChange, path []pathSegment, a, b map[string]interface{}) {
+	for _, key := range JSON(a).Keys() {
+		if _, exists := b[key]; !exists {
+			*changes = append(*changes, pathChange{path: appendKey(path, key), op: ChangeRemove, old: deepCopy(a[key])})
+		}
+	}
+
+	for _, key := range JSON(b).Keys() {
+		keyPath := appendKey(path, key)
+		if old, exists := a[key]; exists {
+			diffValueChanges(changes, keyPath, old, b[key])
+		} else {
+			*changes = append(*changes, pathChange{path: keyPath, op: ChangeAdd, new: deepCopy(b[key])})
+		}
+	}
+}
+
+// diffValueChanges appends the changes turning the value a at path into b.
+func diffValueChanges(changes *[]pathChange, path []pathSegment, a, b interface{}) {
+	if valuesEqual(a, b, &equalConfig{}) {
+		return
+	}
+
+	x, xIsObj := asObject(a)
+	y, yIsObj := asObject(b)
+	if xIsObj && yIsObj {
+		diffObjectChanges(changes, path, x, y)
+		return
+	}
+
+	xArr, xIsArr := a.([]interface{})
+	yArr, yIsArr := b.([]interface{})
+	if !xIsArr || !yIsArr {
+		*changes = append(*changes, pathChange{path: path, op: ChangeChange, old: deepCopy(a), new: deepCopy(b)})
+		return
+	}
+
+	common := min(len(xArr), len(yArr))
+	for i := 0; i < common; i++ {
+		diffValueChanges(changes, appendIndex(path, i), xArr[i], yArr[i])
+	}
+	for i := len(xArr) - 1; i >= common; i-- {
+		*changes = append(*changes, pathChange{path: appendIndex(path, i), op: ChangeRemove, old: deepCopy(xArr[i])})
+	}
+	for i := common; i < len(yArr); i++ {
+		*changes = append(*changes, pathChange{path: appendIndex(path, i), op: ChangeAdd, new: deepCopy(yArr[i])})
+	}
+}
+
+// appendKey returns a copy of path followed by the object key.
+func appendKey(path []pathSegment, key string) []pathSegment {
+	return append(path[:len(path):len(path)], pathSegment{key: key})
+}
+
+// appendIndex returns a copy of path followed by the array index i.
+func appendIndex(path []pathSegment, i int) []pathSegment {
+	return append(path[:len(path):len(path)], pathSegment{index: i, isIndex: true})
+}
+
+// formatPath formats segments as a path, see Get.
+func formatPath(segments []pathSegment) string {
+	var path string
+	for _, seg := range segments {
+		if seg.isIndex {
+			path = joinPathIndex(path, seg.index)
+		} else {
+			path = joinPathKey(path, seg.key)
+		}
+	}
+	return path
+}
+
+// formatPointer formats segments as an RFC 6901 JSON pointer.
+func formatPointer(segments []pathSegment) string {
+	var pointer strings.Builder
+	for _, seg := range segments {
+		pointer.WriteByte('/')
+		if seg.isIndex {
+			pointer.WriteString(strconv.Itoa(seg.index))
+		} else {
+			pointer.WriteString(pointerEscaper.Replace(seg.key))
+		}
+	}
+	return pointer.String()
+}
+
+// String returns the change as "+ path: new", "- path: old" or
+// "~ path: old -> new", with values as compact JSON.
+func (c Change) String() string {
+	switch c.Op {
+	case ChangeAdd:
+		return fmt.Sprintf("+ %s: %s", c.Path, changeValue(c.New))
+	case ChangeRemove:
+		return fmt.Sprintf("- %s: %s", c.Path, changeValue(c.Old))
+	}
+	return fmt.Sprintf("~ %s: %s -> %s", c.Path, changeValue(c.Old), changeValue(c.New))
+}
+
+// String returns the changes one per line, see Change.String.
+func (c Changes) String() string {
+	lines := make([]string, len(c))
+	for i, change := range c {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// changeValue formats v as compact JSON, falling back to Go's formatting.
+func changeValue(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,137 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want dbtypes.Changes
+	}{
+		{
+			name: "equal",
+			a:    `{"a":1,"b":[1,{"c":true}]}`,
+			b:    `{"b":[1,{"c":true}],"a":1}`,
+		},
+		{
+			name: "add and remove",
+			a:    `{"name":"Ann","age":41}`,
+			b:    `{"name":"Ann","email":"ann@example.com"}`,
+			want: dbtypes.Changes{
+				{Path: "age", Op: dbtypes.ChangeRemove, Old: 41.0},
+				{Path: "email", Op: dbtypes.ChangeAdd, New: "ann@example.com"},
+			},
+		},
+		{
+			name: "nested change",
+			a:    `{"address":{"city":"Kampala","zip":null}}`,
+			b:    `{"address":{"city":"Entebbe","zip":null}}`,
+			want: dbtypes.Changes{
+				{Path: "address.city", Op: dbtypes.ChangeChange, Old: "Kampala", New: "Entebbe"},
+			},
+		},
+		{
+			name: "null is a value",
+			a:    `{"manager":null}`,
+			b:    `{"manager":"Bob"}`,
+			want: dbtypes.Changes{
+				{Path: "manager", Op: dbtypes.ChangeChange, Old: nil, New: "Bob"},
+			},
+		},
+		{
+			name: "type change",
+			a:    `{"tags":"a"}`,
+			b:    `{"tags":["a"]}`,
+			want: dbtypes.Changes{
+				{Path: "tags", Op: dbtypes.ChangeChange, Old: "a", New: []interface{}{"a"}},
+			},
+		},
+		{
+			name: "array edits",
+			a:    `{"items":[{"sku":"A","qty":1},"x","y","z"]}`,
+			b:    `{"items":[{"sku":"A","qty":2},"x"]}`,
+			want: dbtypes.Changes{
+				{Path: "items[0].qty", Op: dbtypes.ChangeChange, Old: 1.0, New: 2.0},
+				{Path: "items[3]", Op: dbtypes.ChangeRemove, Old: "z"},
+				{Path: "items[2]", Op: dbtypes.ChangeRemove, Old: "y"},
+			},
+		},
+		{
+			name: "array append",
+			a:    `{"items":[]}`,
+			b:    `{"items":[1,2]}`,
+			want: dbtypes.Changes{
+				{Path: "items[0]", Op: dbtypes.ChangeAdd, New: 1.0},
+				{Path: "items[1]", Op: dbtypes.ChangeAdd, New: 2.0},
+			},
+		},
+		{
+			name: "escaped keys",
+			a:    `{"a.b":{"c[0]":1}}`,
+			b:    `{"a.b":{"c[0]":2}}`,
+			want: dbtypes.Changes{
+				{Path: `a\.b.c\[0]`, Op: dbtypes.ChangeChange, Old: 1.0, New: 2.0},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dbtypes.JSONDiff(mustJSON(t, tt.a), mustJSON(t, tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("JSONDiff() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONDiffNumbers(t *testing.T) {
+	a := dbtypes.JSON{"a": 1, "b": json.Number("2.0"), "c": 3.5}
+	b := dbtypes.JSON{"a": 1.0, "b": int64(2), "c": json.Number("3.5")}
+	if got := dbtypes.JSONDiff(a, b); len(got) != 0 {
+		t.Errorf("JSONDiff() = %v, want no changes", got)
+	}
+}
+
+func TestJSONDiffNil(t *testing.T) {
+	got := dbtypes.JSONDiff(nil, dbtypes.JSON{"a": true})
+	want := dbtypes.Changes{{Path: "a", Op: dbtypes.ChangeAdd, New: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("JSONDiff(nil, ...) = %v, want %v", got, want)
+	}
+	if got := dbtypes.JSONDiff(nil, nil); len(got) != 0 {
+		t.Errorf("JSONDiff(nil, nil) = %v, want no changes", got)
+	}
+}
+
+func TestJSONDiffCopies(t *testing.T) {
+	b := mustJSON(t, `{"tags":["a"]}`)
+	changes := dbtypes.JSONDiff(nil, b)
+	if err := b.Set("tags[0]", "changed"); err != nil {
+		t.Fatal(err)
+	}
+	if got := changes[0].New.([]interface{})[0]; got != "a" {
+		t.Errorf("Change.New = %v after modifying the document, want a", got)
+	}
+}
+
+func TestChangesString(t *testing.T) {
+	changes := dbtypes.JSONDiff(
+		mustJSON(t, `{"name":"Ann","age":41,"tags":["a"]}`),
+		mustJSON(t, `{"name":"Anne","tags":["a","b"]}`),
+	)
+	want := "- age: 41\n~ name: \"Ann\" -> \"Anne\"\n+ tags[1]: \"b\""
+	if got := changes.String(); got != want {
+		t.Errorf("Changes.String() = %q, want %q", got, want)
+	}
+
+	if got := (dbtypes.Changes{}).String(); got != "" {
+		t.Errorf("empty Changes.String() = %q, want \"\"", got)
+	}
+}
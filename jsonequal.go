@@ -0,0 +1,114 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// EqualOption configures Equal.
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	nullAsMissing bool
+}
+
+// EqualNullAsMissing makes Equal treat object members that are null
+// as if they were missing, so {"a":1,"b":null} equals {"a":1}.
+func EqualNullAsMissing() EqualOption {
+	return func(c *equalConfig) {
+		c.nullAsMissing = true
+	}
+}
+
+// Equal reports whether j and other hold the same JSON document, e.g. to
+// skip an UPDATE when a column did not change. Object members are compared
+// regardless of order and numbers by value, so 1, 1.0, int64(1) and
+// json.Number("1") are equal. Integers are compared exactly, even above 2^53.
+// A nil object only equals another nil object, since it is stored as NULL.
+func (j JSON) Equal(other JSON, opts ...EqualOption) bool {
+	var cfg equalConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if (j == nil) != (other == nil) {
+		return false
+	}
+	return valuesEqual(map[string]interface{}(j), map[string]interface{}(other), &cfg)
+}
+
+// EqualStrict reports whether j and other are structurally identical,
+// including the Go types of their values, like reflect.DeepEqual.
+// Unlike Equal, float64(1) and json.Number("1") are different.
+func (j JSON) EqualStrict(other JSON) bool {
+	return reflect.DeepEqual(j, other)
+}
+
+// valuesEqual reports whether a and b are equal JSON values, see Equal.
+func valuesEqual(a, b interface{}, cfg *equalConfig) bool {
+	if x, ok := asObject(a); ok {
+		y, ok := asObject(b)
+		if !ok {
+			return false
+		}
+		return objectsEqual(x, y, cfg)
+	}
+
+	if x, ok := a.([]interface{}); ok {
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !valuesEqual(x[i], y[i], cfg) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if x, ok := a.(json.Number); ok {
+		if y, ok := b.(json.Number); ok && x == y {
+			return true
+		}
+	}
+	if x, err := toInt64(a); err == nil {
+		if y, err := toInt64(b); err == nil {
+			return x == y
+		}
+	}
+	if x, ok := toFloat64(a); ok {
+		y, ok := toFloat64(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// objectsEqual reports whether the objects x and y have equal members.
+func objectsEqual(x, y map[string]interface{}, cfg *equalConfig) bool {
+	if !cfg.nullAsMissing && len(x) != len(y) {
+		return false
+	}
+
+	for key, value := range x {
+		other, exists := y[key]
+		if !exists {
+			if cfg.nullAsMissing && value == nil {
+				continue
+			}
+			return false
+		}
+		if !valuesEqual(value, other, cfg) {
+			return false
+		}
+	}
+
+	if cfg.nullAsMissing {
+		for key, value := range y {
+			if _, exists := x[key]; !exists && value != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
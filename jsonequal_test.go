@@ -0,0 +1,90 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b dbtypes.JSON
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"nil and empty", nil, dbtypes.JSON{}, false},
+		{"key order", mustJSON(t, `{"a":1,"b":2}`), mustJSON(t, `{"b":2,"a":1}`), true},
+		{"float and int", dbtypes.JSON{"n": float64(1)}, dbtypes.JSON{"n": 1}, true},
+		{"float and json.Number", dbtypes.JSON{"n": 1.0}, dbtypes.JSON{"n": json.Number("1")}, true},
+		{"json.Number exponent", dbtypes.JSON{"n": json.Number("1e3")}, dbtypes.JSON{"n": json.Number("1000")}, true},
+		{"large integers", dbtypes.JSON{"n": json.Number("9007199254740993")}, dbtypes.JSON{"n": json.Number("9007199254740992")}, false},
+		{"large integer and int64", dbtypes.JSON{"n": json.Number("9007199254740993")}, dbtypes.JSON{"n": int64(9007199254740993)}, true},
+		{"fractions", dbtypes.JSON{"n": 1.5}, dbtypes.JSON{"n": json.Number("1.50")}, true},
+		{"different numbers", dbtypes.JSON{"n": 1.5}, dbtypes.JSON{"n": 1}, false},
+		{"number and string", dbtypes.JSON{"n": 1}, dbtypes.JSON{"n": "1"}, false},
+		{"number and bool", dbtypes.JSON{"n": 1}, dbtypes.JSON{"n": true}, false},
+		{"nested arrays", mustJSON(t, `{"a":[[1,2],[3,{"b":[4]}]]}`), dbtypes.JSON{"a": []interface{}{[]interface{}{1, 2.0}, []interface{}{json.Number("3"), map[string]interface{}{"b": []interface{}{4}}}}}, true},
+		{"array order", mustJSON(t, `{"a":[1,2]}`), mustJSON(t, `{"a":[2,1]}`), false},
+		{"array length", mustJSON(t, `{"a":[1,2]}`), mustJSON(t, `{"a":[1,2,null]}`), false},
+		{"typed nested object", dbtypes.JSON{"a": dbtypes.JSON{"b": 1}}, mustJSON(t, `{"a":{"b":1}}`), true},
+		{"explicit null", mustJSON(t, `{"a":null}`), mustJSON(t, `{"a":null}`), true},
+		{"null and missing", mustJSON(t, `{"a":1,"b":null}`), mustJSON(t, `{"a":1}`), false},
+		{"null and value", mustJSON(t, `{"a":null}`), mustJSON(t, `{"a":0}`), false},
+		{"object and array", mustJSON(t, `{"a":{}}`), mustJSON(t, `{"a":[]}`), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Equal(tt.b); got != tt.want {
+			t.Errorf("%s: Equal() = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := tt.b.Equal(tt.a); got != tt.want {
+			t.Errorf("%s: reversed Equal() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestJSONEqualNullAsMissing(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`{"a":1,"b":null}`, `{"a":1}`, true},
+		{`{"a":{"b":null,"c":[null]}}`, `{"a":{"c":[null]}}`, true},
+		{`{"a":[{"b":null}]}`, `{"a":[{}]}`, true},
+		{`{"a":[null]}`, `{"a":[]}`, false},
+		{`{"a":null}`, `{"a":0}`, false},
+		{`{"a":null}`, `{"b":1}`, false},
+	}
+
+	for _, tt := range tests {
+		a, b := mustJSON(t, tt.a), mustJSON(t, tt.b)
+		if got := a.Equal(b, dbtypes.EqualNullAsMissing()); got != tt.want {
+			t.Errorf("%s.Equal(%s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := b.Equal(a, dbtypes.EqualNullAsMissing()); got != tt.want {
+			t.Errorf("%s.Equal(%s) = %v, want %v", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestJSONEqualStrict(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b dbtypes.JSON
+		want bool
+	}{
+		{"identical", mustJSON(t, `{"a":[1,{"b":null}]}`), mustJSON(t, `{"a":[1,{"b":null}]}`), true},
+		{"float and int", dbtypes.JSON{"n": float64(1)}, dbtypes.JSON{"n": 1}, false},
+		{"float and json.Number", dbtypes.JSON{"n": 1.0}, dbtypes.JSON{"n": json.Number("1")}, false},
+		{"nil and empty", nil, dbtypes.JSON{}, false},
+		{"null and missing", mustJSON(t, `{"a":null}`), mustJSON(t, `{}`), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.EqualStrict(tt.b); got != tt.want {
+			t.Errorf("%s: EqualStrict() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package dbtypes
+
+import (
+	"iter"
+	"sort"
+)
+
+// Keys returns the keys of j in ascending order.
+// It returns an empty slice for nil objects.
+func (j JSON) Keys() []string {
+	keys := make([]string, 0, len(j))
+	for key := range j {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Values returns the values of j in the order of Keys.
+func (j JSON) Values() []interface{} {
+	values := make([]interface{}, 0, len(j))
+	for _, key := range j.Keys() {
+		values = append(values, j[key])
+	}
+	return values
+}
+
+// SortedEntries returns an iterator over the keys and values of j
+// in the order of Keys.
+func (j JSON) SortedEntries() iter.Seq2[string, interface{}] {
+	return func(yield func(string, interface{}) bool) {
+		for _, key := range j.Keys() {
+			if !yield(key, j[key]) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of top-level keys in j.
+func (j JSON) Len() int {
+	return len(j)
+}
+
+// Has reports whether j has the top-level key, even if its value is null.
+func (j JSON) Has(key string) bool {
+	_, ok := j[key]
+	return ok
+}
+
+// HasPath reports whether j has a value at path (see Get), even if it is null.
+func (j JSON) HasPath(path string) bool {
+	_, ok := j.Get(path)
+	return ok
+}
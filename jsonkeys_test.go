@@ -0,0 +1,108 @@
+package dbtypes_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONKeys(t *testing.T) {
+	j := mustJSON(t, `{"zeta":1,"alpha":{"b":2},"Beta":null,"_id":"x","10":true,"9":false}`)
+
+	wantKeys := []string{"10", "9", "Beta", "_id", "alpha", "zeta"}
+	if got := j.Keys(); !reflect.DeepEqual(got, wantKeys) {
+		t.Errorf("Keys() = %q, want %q", got, wantKeys)
+	}
+
+	wantValues := []interface{}{true, false, nil, "x", map[string]interface{}{"b": 2.0}, 1.0}
+	if got := j.Values(); !reflect.DeepEqual(got, wantValues) {
+		t.Errorf("Values() = %v, want %v", got, wantValues)
+	}
+
+	var keys []string
+	var values []interface{}
+	for key, value := range j.SortedEntries() {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	if !reflect.DeepEqual(keys, wantKeys) || !reflect.DeepEqual(values, wantValues) {
+		t.Errorf("SortedEntries() = %q %v, want %q %v", keys, values, wantKeys, wantValues)
+	}
+
+	keys = nil
+	for key := range j.SortedEntries() {
+		keys = append(keys, key)
+		if len(keys) == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(keys, wantKeys[:2]) {
+		t.Errorf("SortedEntries() with break = %q, want %q", keys, wantKeys[:2])
+	}
+
+	if got := j.Len(); got != 6 {
+		t.Errorf("Len() = %d, want 6", got)
+	}
+}
+
+func TestJSONHas(t *testing.T) {
+	j := mustJSON(t, `{"a":{"b":null,"c":[{"d":1}]},"e":null,"f.g":1}`)
+
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"a", true},
+		{"e", true},
+		{"f.g", true},
+		{"b", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := j.Has(tt.key); got != tt.want {
+			t.Errorf("Has(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+
+	pathTests := []struct {
+		path string
+		want bool
+	}{
+		{"a", true},
+		{"a.b", true},
+		{"a.c[0].d", true},
+		{"e", true},
+		{`f\.g`, true},
+		{"f.g", false},
+		{"a.c[1]", false},
+		{"a.x", false},
+		{"e.x", false},
+		{"a[", false},
+	}
+	for _, tt := range pathTests {
+		if got := j.HasPath(tt.path); got != tt.want {
+			t.Errorf("HasPath(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestJSONKeysNil(t *testing.T) {
+	var j dbtypes.JSON
+
+	if got := j.Keys(); got == nil || len(got) != 0 {
+		t.Errorf("nil Keys() = %#v, want empty slice", got)
+	}
+	if got := j.Values(); len(got) != 0 {
+		t.Errorf("nil Values() = %v, want empty", got)
+	}
+	for key := range j.SortedEntries() {
+		t.Errorf("nil SortedEntries() yielded %q", key)
+	}
+	if got := j.Len(); got != 0 {
+		t.Errorf("nil Len() = %d, want 0", got)
+	}
+	if j.Has("a") || j.HasPath("a.b") {
+		t.Errorf("nil Has() or HasPath() = true")
+	}
+}
@@ -0,0 +1,94 @@
+package dbtypes
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrJSONTooLarge is wrapped by *JSONSizeError.
+	ErrJSONTooLarge = errors.New("JSON document is too large")
+
+	// ErrJSONTooDeep is returned for JSON documents nested deeper
+	// than the limit set with SetMaxJSONDepth.
+	ErrJSONTooDeep = errors.New("JSON document is nested too deeply")
+)
+
+// Limits set with SetMaxJSONSize and SetMaxJSONDepth, 0 means unlimited.
+var maxJSONSize, maxJSONDepth int
+
+// SetMaxJSONSize limits the size in bytes of the documents accepted by
+// JSON.Scan, JSON.UnmarshalJSON, JSON.FormScan, JSON.GobDecode, JSONOf and
+// RawJSON, which return a *JSONSizeError for larger documents before
+// decoding them. A limit of 0, the default, means unlimited.
+// This should be called once at program startup.
+func SetMaxJSONSize(bytes int) {
+	maxJSONSize = max(bytes, 0)
+}
+
+// SetMaxJSONDepth limits how deeply objects and arrays may be nested in the
+// documents decoded by JSON and JSONOf, e.g. 1 for {"a":1} and 2 for
+// {"a":[1]}. Deeper documents are rejected with an error wrapping
+// ErrJSONTooDeep before decoding them. A limit of 0, the default, means
+// unlimited. This should be called once at program startup.
+func SetMaxJSONDepth(depth int) {
+	maxJSONDepth = max(depth, 0)
+}
+
+// JSONSizeError is returned when a JSON document is larger than the limit
+// set with SetMaxJSONSize. It wraps ErrJSONTooLarge.
+type JSONSizeError struct {
+	Size  int // The size of the document in bytes
+	Limit int // The limit set with SetMaxJSONSize
+}
+
+func (e *JSONSizeError) Error() string {
+	return fmt.Sprintf("JSON document of %d bytes exceeds the limit of %d bytes", e.Size, e.Limit)
+}
+
+func (e *JSONSizeError) Unwrap() error {
+	return ErrJSONTooLarge
+}
+
+// checkJSONSize returns a *JSONSizeError if size is larger than maxJSONSize.
+func checkJSONSize(size int) error {
+	if maxJSONSize > 0 && size > maxJSONSize {
+		return &JSONSizeError{Size: size, Limit: maxJSONSize}
+	}
+	return nil
+}
+
+// checkJSONLimits checks data against both maxJSONSize and maxJSONDepth
+// without decoding it.
+func checkJSONLimits(data []byte) error {
+	if err := checkJSONSize(len(data)); err != nil {
+		return err
+	}
+	if maxJSONDepth == 0 {
+		return nil
+	}
+
+	depth := 0
+	inString, escaped := false, false
+	for _, c := range data {
+		switch {
+		case escaped:
+			escaped = false
+		case inString:
+			if c == '\\' {
+				escaped = true
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			if depth++; depth > maxJSONDepth {
+				return fmt.Errorf("%w: more than %d levels", ErrJSONTooDeep, maxJSONDepth)
+			}
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestSetMaxJSONSize(t *testing.T) {
+	dbtypes.SetMaxJSONSize(1 << 10)
+	defer dbtypes.SetMaxJSONSize(0)
+
+	small := []byte(`{"a":"` + strings.Repeat("x", 100) + `"}`)
+	large := []byte(`{"a":"` + strings.Repeat("x", 1<<20) + `"}`)
+
+	decoders := map[string]func([]byte) error{
+		"JSON.Scan":          func(b []byte) error { var j dbtypes.JSON; return j.Scan(b) },
+		"JSON.Scan(string)":  func(b []byte) error { var j dbtypes.JSON; return j.Scan(string(b)) },
+		"JSON.UnmarshalJSON": func(b []byte) error { var j dbtypes.JSON; return j.UnmarshalJSON(b) },
+		"JSON.FormScan":      func(b []byte) error { var j dbtypes.JSON; return j.FormScan(b) },
+		"JSON.UnmarshalGQL":  func(b []byte) error { var j dbtypes.JSON; return j.UnmarshalGQL(string(b)) },
+		"RawJSON.Scan":       func(b []byte) error { var r dbtypes.RawJSON; return r.Scan(b) },
+		"JSONOf.Scan":        func(b []byte) error { var j dbtypes.JSONOf[map[string]string]; return j.Scan(b) },
+	}
+
+	for name, decode := range decoders {
+		if err := decode(small); err != nil {
+			t.Errorf("%s() of %d bytes returned error: %v", name, len(small), err)
+		}
+
+		err := decode(large)
+		var sizeErr *dbtypes.JSONSizeError
+		if !errors.As(err, &sizeErr) || !errors.Is(err, dbtypes.ErrJSONTooLarge) {
+			t.Errorf("%s() error = %v, want *JSONSizeError", name, err)
+			continue
+		}
+		if sizeErr.Size != len(large) || sizeErr.Limit != 1<<10 {
+			t.Errorf("%s() error = %+v, want size %d and limit %d", name, sizeErr, len(large), 1<<10)
+		}
+
+		// The document is rejected before it is copied or decoded.
+		allocs := testing.AllocsPerRun(10, func() { decode(large) })
+		if allocs > 5 {
+			t.Errorf("%s() of an oversized document made %v allocations", name, allocs)
+		}
+	}
+}
+
+func TestSetMaxJSONSizeGob(t *testing.T) {
+	j := dbtypes.JSON{"a": strings.Repeat("x", 4<<10)}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(j); err != nil {
+		t.Fatal(err)
+	}
+
+	dbtypes.SetMaxJSONSize(1 << 10)
+	defer dbtypes.SetMaxJSONSize(0)
+
+	var got dbtypes.JSON
+	if err := gob.NewDecoder(&buf).Decode(&got); !errors.Is(err, dbtypes.ErrJSONTooLarge) {
+		t.Errorf("gob Decode() error = %v, want ErrJSONTooLarge", err)
+	}
+}
+
+func TestSetMaxJSONDepth(t *testing.T) {
+	dbtypes.SetMaxJSONDepth(32)
+	defer dbtypes.SetMaxJSONDepth(0)
+
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr bool
+	}{
+		{"flat", `{"a":1}`, false},
+		{"at limit", `{"a":` + strings.Repeat("[", 31) + strings.Repeat("]", 31) + `}`, false},
+		{"brackets in strings", `{"a":"` + strings.Repeat("[{", 100) + `\"[{"}`, false},
+		{"over limit", `{"a":` + strings.Repeat("[", 32) + strings.Repeat("]", 32) + `}`, true},
+		{"pathological", `{"a":` + strings.Repeat(`{"b":[`, 100000), true},
+	}
+
+	for _, tt := range tests {
+		var j dbtypes.JSON
+		err := j.Scan(tt.doc)
+		if tt.wantErr != errors.Is(err, dbtypes.ErrJSONTooDeep) {
+			t.Errorf("%s: Scan() error = %v, want ErrJSONTooDeep %v", tt.name, err, tt.wantErr)
+		}
+
+		err = j.UnmarshalJSON([]byte(tt.doc))
+		if tt.wantErr != errors.Is(err, dbtypes.ErrJSONTooDeep) {
+			t.Errorf("%s: UnmarshalJSON() error = %v, want ErrJSONTooDeep %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	deep := `{"a":` + strings.Repeat(`{"b":[`, 100000)
+	allocs := testing.AllocsPerRun(10, func() {
+		var j dbtypes.JSON
+		j.Scan(deep)
+	})
+	if allocs > 5 {
+		t.Errorf("Scan() of a deeply nested document made %v allocations", allocs)
+	}
+}
+
+func TestJSONLimitsDisabledByDefault(t *testing.T) {
+	doc := `{"a":` + strings.Repeat("[", 1000) + strings.Repeat("]", 1000) + `,"b":"` + strings.Repeat("x", 1<<20) + `"}`
+
+	var j dbtypes.JSON
+	if err := j.Scan(doc); err != nil {
+		t.Errorf("Scan() returned error: %v", err)
+	}
+}
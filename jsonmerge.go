@@ -0,0 +1,119 @@
+package dbtypes
+
+import "reflect"
+
+// ArrayStrategy controls how Merge combines arrays present in both documents.
+type ArrayStrategy int
+
+const (
+	// ArrayReplace replaces the array with the one from the other document.
+	ArrayReplace ArrayStrategy = iota
+
+	// ArrayConcat appends the elements of the other array.
+	ArrayConcat
+
+	// ArrayUnion appends the elements of the other array that are not
+	// already present, compared with reflect.DeepEqual.
+	ArrayUnion
+)
+
+// MergeOption configures Merge.
+type MergeOption func(*mergeConfig)
+
+type mergeConfig struct {
+	arrays      ArrayStrategy
+	nullDeletes bool
+	inPlace     bool
+}
+
+// MergeArrays sets the strategy for arrays present in both documents.
+// The default is ArrayReplace.
+func MergeArrays(strategy ArrayStrategy) MergeOption {
+	return func(c *mergeConfig) {
+		c.arrays = strategy
+	}
+}
+
+// MergeNullDeletes makes explicit nulls in the other document delete the key
+// instead of setting it to null.
+func MergeNullDeletes() MergeOption {
+	return func(c *mergeConfig) {
+		c.nullDeletes = true
+	}
+}
+
+// MergeInPlace makes Merge modify and return the receiver instead of a copy.
+// A nil receiver cannot be modified, so a new object is returned for it.
+func MergeInPlace() MergeOption {
+	return func(c *mergeConfig) {
+		c.inPlace = true
+	}
+}
+
+// Merge returns a deep merge of other onto j, e.g. user preferences onto
+// defaults. Objects present in both are merged key by key, arrays are
+// combined according to MergeArrays and other values from other win.
+// Unless MergeInPlace is given, j is not modified and the result shares
+// no objects or arrays with j or other. Merging two nil objects returns nil.
+func (j JSON) Merge(other JSON, opts ...MergeOption) JSON {
+	var cfg mergeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if j == nil && other == nil {
+		return nil
+	}
+
+	dst := map[string]interface{}(j)
+	if !cfg.inPlace || dst == nil {
+		dst = cloneObject(dst)
+	}
+	mergeObject(dst, other, &cfg)
+	return JSON(dst)
+}
+
+// mergeObject merges src into dst, see Merge.
+func mergeObject(dst, src map[string]interface{}, cfg *mergeConfig) {
+	for key, value := range src {
+		if value == nil && cfg.nullDeletes {
+			delete(dst, key)
+			continue
+		}
+
+		if srcObj, ok := asObject(value); ok {
+			dstObj, ok := asObject(dst[key])
+			if !ok {
+				dstObj = map[string]interface{}{}
+			}
+			mergeObject(dstObj, srcObj, cfg)
+			dst[key] = dstObj
+			continue
+		}
+
+		srcArr, srcIsArr := value.([]interface{})
+		dstArr, dstIsArr := dst[key].([]interface{})
+		if srcIsArr && dstIsArr && cfg.arrays != ArrayReplace {
+			for _, elem := range srcArr {
+				if cfg.arrays == ArrayUnion && containsValue(dstArr, elem) {
+					continue
+				}
+				dstArr = append(dstArr, deepCopy(elem))
+			}
+			dst[key] = dstArr
+			continue
+		}
+
+		dst[key] = deepCopy(value)
+	}
+}
+
+// containsValue reports whether arr contains a value deeply equal to v.
+func containsValue(arr []interface{}, v interface{}) bool {
+	for _, elem := range arr {
+		if reflect.DeepEqual(elem, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func mustJSON(t *testing.T, s string) dbtypes.JSON {
+	t.Helper()
+
+	var j dbtypes.JSON
+	if err := j.Scan(s); err != nil {
+		t.Fatalf("Scan(%s) returned error: %v", s, err)
+	}
+	return j
+}
+
+func jsonString(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	return string(data)
+}
+
+func TestJSONMerge(t *testing.T) {
+	const defaults = `{"theme":{"color":"blue","font":{"size":12,"family":"serif"}},"tags":["a","b"],"beta":false,"locale":"en"}`
+
+	tests := []struct {
+		name  string
+		other string
+		opts  []dbtypes.MergeOption
+		want  string
+	}{
+		{
+			name:  "nested conflicts",
+			other: `{"theme":{"font":{"size":14}},"beta":true}`,
+			want:  `{"beta":true,"locale":"en","tags":["a","b"],"theme":{"color":"blue","font":{"family":"serif","size":14}}}`,
+		},
+		{
+			name:  "scalar replaces object",
+			other: `{"theme":"dark"}`,
+			want:  `{"beta":false,"locale":"en","tags":["a","b"],"theme":"dark"}`,
+		},
+		{
+			name:  "object replaces scalar",
+			other: `{"locale":{"language":"fr"}}`,
+			want:  `{"beta":false,"locale":{"language":"fr"},"tags":["a","b"],"theme":{"color":"blue","font":{"family":"serif","size":12}}}`,
+		},
+		{
+			name:  "arrays replace",
+			other: `{"tags":["b","c"]}`,
+			want:  `{"beta":false,"locale":"en","tags":["b","c"],"theme":{"color":"blue","font":{"family":"serif","size":12}}}`,
+		},
+		{
+			name:  "arrays concat",
+			other: `{"tags":["b","c"]}`,
+			opts:  []dbtypes.MergeOption{dbtypes.MergeArrays(dbtypes.ArrayConcat)},
+			want:  `{"beta":false,"locale":"en","tags":["a","b","b","c"],"theme":{"color":"blue","font":{"family":"serif","size":12}}}`,
+		},
+		{
+			name:  "arrays union",
+			other: `{"tags":["b","c","c"]}`,
+			opts:  []dbtypes.MergeOption{dbtypes.MergeArrays(dbtypes.ArrayUnion)},
+			want:  `{"beta":false,"locale":"en","tags":["a","b","c"],"theme":{"color":"blue","font":{"family":"serif","size":12}}}`,
+		},
+		{
+			name:  "null sets by default",
+			other: `{"locale":null}`,
+			want:  `{"beta":false,"locale":null,"tags":["a","b"],"theme":{"color":"blue","font":{"family":"serif","size":12}}}`,
+		},
+		{
+			name:  "null deletes",
+			other: `{"locale":null,"theme":{"font":{"family":null}},"missing":null}`,
+			opts:  []dbtypes.MergeOption{dbtypes.MergeNullDeletes()},
+			want:  `{"beta":false,"tags":["a","b"],"theme":{"color":"blue","font":{"size":12}}}`,
+		},
+		{
+			name:  "null deletes in new objects",
+			other: `{"extra":{"keep":1,"drop":null}}`,
+			opts:  []dbtypes.MergeOption{dbtypes.MergeNullDeletes()},
+			want:  `{"beta":false,"extra":{"keep":1},"locale":"en","tags":["a","b"],"theme":{"color":"blue","font":{"family":"serif","size":12}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base := mustJSON(t, defaults)
+			other := mustJSON(t, tt.other)
+			otherBefore := jsonString(t, other)
+
+			got := base.Merge(other, tt.opts...)
+			if s := jsonString(t, got); s != tt.want {
+				t.Errorf("Merge() = %s, want %s", s, tt.want)
+			}
+			if s := jsonString(t, base); s != jsonString(t, mustJSON(t, defaults)) {
+				t.Errorf("Merge() modified the receiver: %s", s)
+			}
+			if s := jsonString(t, other); s != otherBefore {
+				t.Errorf("Merge() modified other: %s", s)
+			}
+
+			// The result must not share nested values with the inputs.
+			if _, ok := got.Get("theme.color"); ok {
+				if err := got.Set("theme.color", "red"); err != nil {
+					t.Fatal(err)
+				}
+				if color, _ := base.GetString("theme.color"); color != "blue" {
+					t.Errorf("result shares objects with the receiver")
+				}
+			}
+		})
+	}
+}
+
+func TestJSONMergeInPlace(t *testing.T) {
+	base := mustJSON(t, `{"theme":{"color":"blue"},"tags":["a"]}`)
+	got := base.Merge(mustJSON(t, `{"theme":{"font":"serif"},"tags":["b"]}`),
+		dbtypes.MergeInPlace(), dbtypes.MergeArrays(dbtypes.ArrayConcat))
+
+	want := `{"tags":["a","b"],"theme":{"color":"blue","font":"serif"}}`
+	if s := jsonString(t, got); s != want {
+		t.Errorf("Merge() = %s, want %s", s, want)
+	}
+	if s := jsonString(t, base); s != want {
+		t.Errorf("receiver = %s, want %s", s, want)
+	}
+}
+
+func TestJSONMergeNil(t *testing.T) {
+	var empty dbtypes.JSON
+	if got := empty.Merge(nil); got != nil {
+		t.Errorf("nil.Merge(nil) = %v, want nil", got)
+	}
+
+	got := empty.Merge(dbtypes.JSON{"a": 1.0}, dbtypes.MergeInPlace())
+	if s := jsonString(t, got); s != `{"a":1}` {
+		t.Errorf("nil.Merge() = %s, want {\"a\":1}", s)
+	}
+
+	base := dbtypes.JSON{"a": 1.0}
+	if s := jsonString(t, base.Merge(nil)); s != `{"a":1}` {
+		t.Errorf("Merge(nil) = %s, want {\"a\":1}", s)
+	}
+}
@@ -0,0 +1,163 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONOf is a JSON column decoded into a value of type T, e.g.
+// JSONOf[UserSettings], for typed access instead of a JSON map.
+// NULL columns leave Data as the zero value of T and Valid false.
+type JSONOf[T any] struct {
+	Data  T
+	Valid bool // Valid is true if the column is not NULL
+}
+
+// NewJSONOf returns a valid JSONOf wrapping data.
+func NewJSONOf[T any](data T) JSONOf[T] {
+	return JSONOf[T]{Data: data, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface. It accepts JSON text as
+// []byte or string and NULL. NULL, empty text and the JSON literal null
+// set Data to the zero value and Valid to false.
+func (j *JSONOf[T]) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		j.setNull()
+		return nil
+	case []byte:
+		return j.scanText(v)
+	case string:
+		return j.scanText([]byte(v))
+	default:
+		return &ScanTypeError{Value: value, Target: "JSONOf"}
+	}
+}
+
+// scanText decodes JSON text into a new T, see Scan.
+func (j *JSONOf[T]) scanText(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		j.setNull()
+		return nil
+	}
+
+	if err := checkJSONLimits(data); err != nil {
+		return err
+	}
+	var v T
+	if err := decodeJSON(data, &v); err != nil {
+		return err
+	}
+	j.Data, j.Valid = v, true
+	return nil
+}
+
+func (j *JSONOf[T]) setNull() {
+	var zero T
+	j.Data, j.Valid = zero, false
+}
+
+// Value implements the driver.Valuer interface.
+// It returns Data as JSON text, or NULL if j is not valid.
+func (j JSONOf[T]) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(j.Data)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (j JSONOf[T]) GormDataType() string {
+	return "jsonb"
+}
+
+// MarshalJSON marshals Data inline, or null if j is not valid.
+func (j JSONOf[T]) MarshalJSON() ([]byte, error) {
+	if !j.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(j.Data)
+}
+
+// UnmarshalJSON sets j to NULL for null, otherwise decodes data into Data.
+func (j *JSONOf[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		j.setNull()
+		return nil
+	}
+
+	if err := checkJSONLimits(data); err != nil {
+		return err
+	}
+	var v T
+	if err := decodeJSON(data, &v); err != nil {
+		return err
+	}
+	j.Data, j.Valid = v, true
+	return nil
+}
+
+// FormScan implements the FormScanner interface (see Date.FormScan).
+// value may be JSON text as a string, []byte or []string (the first element
+// is used). An empty string sets j to NULL.
+func (j *JSONOf[T]) FormScan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case []string:
+		if len(v) > 0 {
+			s = v[0]
+		}
+	default:
+		return fmt.Errorf("invalid JSON. Expected value as a string")
+	}
+	return j.scanText([]byte(s))
+}
+
+// GobEncode encodes j as a validity byte followed by the gob-encoded Data.
+func (j JSONOf[T]) GobEncode() ([]byte, error) {
+	if !j.Valid {
+		return []byte{0}, nil
+	}
+
+	buffer := bytes.NewBuffer([]byte{1})
+	if err := gob.NewEncoder(buffer).Encode(&j.Data); err != nil {
+		return nil, fmt.Errorf("error encoding JSONOf: %v", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// GobDecode decodes data produced by GobEncode.
+func (j *JSONOf[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("error decoding JSONOf: no data")
+	}
+
+	if data[0] == 0 {
+		j.setNull()
+		return nil
+	}
+	if err := checkJSONSize(len(data)); err != nil {
+		return err
+	}
+
+	var v T
+	if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&v); err != nil {
+		return fmt.Errorf("error decoding JSONOf: %v", err)
+	}
+	j.Data, j.Valid = v, true
+	return nil
+}
@@ -0,0 +1,202 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+type notificationRule struct {
+	Channel string   `json:"channel"`
+	Events  []string `json:"events,omitempty"`
+}
+
+type userSettings struct {
+	Theme    string             `json:"theme"`
+	FontSize *int               `json:"font_size,omitempty"`
+	Rules    []notificationRule `json:"notification_rules"`
+	Matrix   [][]int            `json:"matrix"`
+	Internal string             `json:"-"`
+}
+
+func testSettings() userSettings {
+	size := 14
+	return userSettings{
+		Theme:    "dark",
+		FontSize: &size,
+		Rules: []notificationRule{
+			{Channel: "email", Events: []string{"invoice.paid", "invoice.overdue"}},
+			{Channel: "sms"},
+		},
+		Matrix: [][]int{{1, 2}, {3}},
+	}
+}
+
+const testSettingsJSON = `{"theme":"dark","font_size":14,"notification_rules":[{"channel":"email","events":["invoice.paid","invoice.overdue"]},{"channel":"sms"}],"matrix":[[1,2],[3]]}`
+
+func TestJSONOfScan(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		want      userSettings
+		wantValid bool
+	}{
+		{"bytes", []byte(testSettingsJSON), testSettings(), true},
+		{"string", testSettingsJSON, testSettings(), true},
+		{"nil pointer field", `{"theme":"light","notification_rules":null,"matrix":[]}`, userSettings{Theme: "light", Matrix: [][]int{}}, true},
+		{"NULL", nil, userSettings{}, false},
+		{"null literal", "null", userSettings{}, false},
+		{"empty", []byte(""), userSettings{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Start from a populated value to check that no fields are left over.
+			j := dbtypes.NewJSONOf(userSettings{Theme: "stale", Internal: "stale"})
+			if err := j.Scan(tt.value); err != nil {
+				t.Fatalf("Scan() returned error: %v", err)
+			}
+			if j.Valid != tt.wantValid {
+				t.Errorf("Scan() Valid = %v, want %v", j.Valid, tt.wantValid)
+			}
+			if !reflect.DeepEqual(j.Data, tt.want) {
+				t.Errorf("Scan() Data = %+v, want %+v", j.Data, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONOfScanErrors(t *testing.T) {
+	var j dbtypes.JSONOf[userSettings]
+	if err := j.Scan(`{"theme":1}`); err == nil {
+		t.Errorf("Scan() with a mismatched field type returned no error")
+	}
+	if err := j.Scan(`{"theme":"dark"} {}`); err == nil {
+		t.Errorf("Scan() with trailing data returned no error")
+	}
+
+	var typeErr *dbtypes.ScanTypeError
+	if err := j.Scan(42); !errors.As(err, &typeErr) {
+		t.Errorf("Scan(42) error = %v, want *ScanTypeError", err)
+	}
+}
+
+func TestJSONOfValue(t *testing.T) {
+	got, err := dbtypes.NewJSONOf(testSettings()).Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if got != testSettingsJSON {
+		t.Errorf("Value() = %v, want %s", got, testSettingsJSON)
+	}
+
+	var null dbtypes.JSONOf[userSettings]
+	if got, err := null.Value(); got != nil || err != nil {
+		t.Errorf("NULL Value() = %v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestJSONOfMarshalJSON(t *testing.T) {
+	type account struct {
+		Settings dbtypes.JSONOf[userSettings] `json:"settings"`
+		Extra    dbtypes.JSONOf[[]int]        `json:"extra"`
+	}
+
+	data, err := json.Marshal(account{Settings: dbtypes.NewJSONOf(testSettings())})
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	want := `{"settings":` + testSettingsJSON + `,"extra":null}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got account
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if !got.Settings.Valid || !reflect.DeepEqual(got.Settings.Data, testSettings()) {
+		t.Errorf("json.Unmarshal() Settings = %+v, want %+v", got.Settings, testSettings())
+	}
+	if got.Extra.Valid || got.Extra.Data != nil {
+		t.Errorf("json.Unmarshal() Extra = %+v, want NULL", got.Extra)
+	}
+}
+
+func TestJSONOfUnmarshalJSONUseNumber(t *testing.T) {
+	dbtypes.JSONUseNumber(true)
+	defer dbtypes.JSONUseNumber(false)
+
+	var record struct {
+		Meta dbtypes.JSONOf[map[string]interface{}] `json:"meta"`
+	}
+	if err := json.Unmarshal([]byte(`{"meta":{"id":12345678901234567890}}`), &record); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if got, want := record.Meta.Data["id"], json.Number("12345678901234567890"); got != want {
+		t.Errorf("json.Unmarshal() id = %#v, want %#v", got, want)
+	}
+
+	var j dbtypes.JSONOf[map[string]interface{}]
+	if err := j.UnmarshalJSON([]byte(`{"a":1} {"b":2}`)); err == nil {
+		t.Errorf("UnmarshalJSON() of trailing data error = nil, want error")
+	}
+}
+
+func TestJSONOfFormScan(t *testing.T) {
+	var j dbtypes.JSONOf[userSettings]
+	if err := j.FormScan([]string{testSettingsJSON}); err != nil {
+		t.Fatalf("FormScan() returned error: %v", err)
+	}
+	if !j.Valid || !reflect.DeepEqual(j.Data, testSettings()) {
+		t.Errorf("FormScan() = %+v, want %+v", j.Data, testSettings())
+	}
+
+	if err := j.FormScan(""); err != nil || j.Valid {
+		t.Errorf("FormScan(\"\") = %+v, %v, want NULL", j, err)
+	}
+	if err := j.FormScan(42); err == nil {
+		t.Errorf("FormScan(42) returned no error")
+	}
+}
+
+func TestJSONOfGob(t *testing.T) {
+	for _, j := range []dbtypes.JSONOf[userSettings]{dbtypes.NewJSONOf(testSettings()), {}} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(j); err != nil {
+			t.Fatalf("gob Encode() returned error: %v", err)
+		}
+
+		var got dbtypes.JSONOf[userSettings]
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("gob Decode() returned error: %v", err)
+		}
+		if !reflect.DeepEqual(got, j) {
+			t.Errorf("gob round trip = %+v, want %+v", got, j)
+		}
+	}
+}
+
+func TestJSONOfDatabase(t *testing.T) {
+	db := openFakeDB(t)
+
+	if _, err := db.Exec("INSERT", dbtypes.NewJSONOf(testSettings()), dbtypes.JSONOf[userSettings]{}); err != nil {
+		t.Fatalf("Exec() returned error: %v", err)
+	}
+
+	var got, null dbtypes.JSONOf[userSettings]
+	if err := db.QueryRow("SELECT").Scan(&got, &null); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if !got.Valid || !reflect.DeepEqual(got.Data, testSettings()) {
+		t.Errorf("Scan() = %+v, want %+v", got.Data, testSettings())
+	}
+	if null.Valid {
+		t.Errorf("Scan(NULL) Valid = true")
+	}
+}
@@ -0,0 +1,322 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPatch is returned for malformed JSON patch operations,
+	// e.g. an unknown op or a missing value.
+	ErrInvalidPatch = errors.New("invalid JSON patch")
+
+	// ErrPatchTestFailed is returned when a JSON patch "test" operation
+	// does not match the document.
+	ErrPatchTestFailed = errors.New("JSON patch test failed")
+)
+
+var (
+	pointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// parsePointer parses an RFC 6901 JSON pointer into its reference tokens.
+// The empty pointer refers to the whole document and has no tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, ErrInvalidPath
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for k := 0; k < len(token); k++ {
+			if token[k] == '~' && (k+1 == len(token) || (token[k+1] != '0' && token[k+1] != '1')) {
+				return nil, ErrInvalidPath
+			}
+		}
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+// pointerIndex parses an array index token referring to an element
+// of an array of length n. If allowEnd is set, n itself and "-" are
+// accepted and refer to the position after the last element.
+func pointerIndex(token string, n int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return n, nil
+	}
+	if !isDigits(token) || (len(token) > 1 && token[0] == '0') {
+		return 0, ErrInvalidPath
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index > n || (index == n && !allowEnd) {
+		return 0, ErrInvalidPath
+	}
+	return index, nil
+}
+
+// pointerGet returns the value at tokens in node.
+func pointerGet(node interface{}, tokens []string) (interface{}, error) {
+	for _, token := range tokens {
+		if obj, ok := asObject(node); ok {
+			value, exists := obj[token]
+			if !exists {
+				return nil, ErrKeyNotFound
+			}
+			node = value
+			continue
+		}
+
+		arr, ok := node.([]interface{})
+		if !ok {
+			return nil, ErrPathConflict
+		}
+		index, err := pointerIndex(token, len(arr), false)
+		if err != nil {
+			return nil, err
+		}
+		node = arr[index]
+	}
+	return node, nil
+}
+
+// pointerAdd adds value at tokens in node following the "add" operation:
+// object members are set and array elements are inserted.
+// It returns the updated node.
+func pointerAdd(node interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	token, last := tokens[0], len(tokens) == 1
+	if obj, ok := asObject(node); ok {
+		if last {
+			obj[token] = value
+			return node, nil
+		}
+
+		child, exists := obj[token]
+		if !exists {
+			return nil, ErrKeyNotFound
+		}
+		child, err := pointerAdd(child, tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		obj[token] = child
+		return node, nil
+	}
+
+	arr, ok := node.([]interface{})
+	if !ok {
+		return nil, ErrPathConflict
+	}
+	index, err := pointerIndex(token, len(arr), last)
+	if err != nil {
+		return nil, err
+	}
+	if last {
+		arr = append(arr, nil)
+		copy(arr[index+1:], arr[index:])
+		arr[index] = value
+		return arr, nil
+	}
+
+	child, err := pointerAdd(arr[index], tokens[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	arr[index] = child
+	return arr, nil
+}
+
+// pointerRemove removes the value at tokens in node, shifting following
+// array elements down. It returns the updated node.
+func pointerRemove(node interface{}, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, ErrInvalidPath
+	}
+
+	token, last := tokens[0], len(tokens) == 1
+	if obj, ok := asObject(node); ok {
+		child, exists := obj[token]
+		if !exists {
+			return nil, ErrKeyNotFound
+		}
+		if last {
+			delete(obj, token)
+			return node, nil
+		}
+
+		child, err := pointerRemove(child, tokens[1:])
+		if err != nil {
+			return nil, err
+		}
+		obj[token] = child
+		return node, nil
+	}
+
+	arr, ok := node.([]interface{})
+	if !ok {
+		return nil, ErrPathConflict
+	}
+	index, err := pointerIndex(token, len(arr), false)
+	if err != nil {
+		return nil, err
+	}
+	if last {
+		return append(arr[:index], arr[index+1:]...), nil
+	}
+
+	child, err := pointerRemove(arr[index], tokens[1:])
+	if err != nil {
+		return nil, err
+	}
+	arr[index] = child
+	return arr, nil
+}
+
+// ApplyPatch applies an RFC 6902 JSON patch, a JSON array of add, remove,
+// replace, move, copy and test operations whose paths are RFC 6901 JSON
+// pointers like "/items/0/price". Array elements are addressed by index
+// and "-" appends; "~1" and "~0" escape "/" and "~" within keys.
+//
+// The patch is applied atomically: j is never modified and if any operation
+// fails, including a "test", no result is returned. A nil j is patched as
+// an empty object. Errors wrap ErrInvalidPatch for malformed operations,
+// ErrPatchTestFailed, ErrKeyNotFound for missing members, ErrInvalidPath for
+// malformed pointers and bad array indices, ErrPathConflict for pointers
+// through scalars and ErrNotObject if the result is not an object.
+func (j JSON) ApplyPatch(patch []byte) (JSON, error) {
+	var ops []map[string]json.RawMessage
+	if err := decodeJSON(patch, &ops); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+
+	var doc interface{} = cloneObject(j)
+	for i, op := range ops {
+		var err error
+		if doc, err = applyPatchOperation(doc, op); err != nil {
+			return nil, fmt.Errorf("patch operation %d: %w", i, err)
+		}
+	}
+
+	obj, ok := asObject(doc)
+	if !ok {
+		return nil, ErrNotObject
+	}
+	return JSON(obj), nil
+}
+
+// applyPatchOperation applies a single JSON patch operation to doc
+// and returns the updated document.
+func applyPatchOperation(doc interface{}, op map[string]json.RawMessage) (_ interface{}, err error) {
+	var name, path string
+	if err := json.Unmarshal(op["op"], &name); err != nil {
+		return nil, fmt.Errorf("%w: missing or invalid op", ErrInvalidPatch)
+	}
+	if err := json.Unmarshal(op["path"], &path); err != nil {
+		return nil, fmt.Errorf("%w: missing or invalid path", ErrInvalidPatch)
+	}
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%s %q: %w", name, path, err)
+		}
+	}()
+
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	switch name {
+	case "add", "replace", "test":
+		raw, ok := op["value"]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing value", ErrInvalidPatch)
+		}
+		if err := decodeJSON(raw, &value); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+	case "move", "copy":
+		var from string
+		if err := json.Unmarshal(op["from"], &from); err != nil {
+			return nil, fmt.Errorf("%w: missing or invalid from", ErrInvalidPatch)
+		}
+		fromTokens, err := parsePointer(from)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = pointerGet(doc, fromTokens); err != nil {
+			return nil, err
+		}
+
+		if name == "copy" {
+			return pointerAdd(doc, tokens, deepCopy(value))
+		}
+		if from == path {
+			return doc, nil
+		}
+		if strings.HasPrefix(path, from+"/") {
+			return nil, fmt.Errorf("%w: cannot move a value into itself", ErrInvalidPatch)
+		}
+		if doc, err = pointerRemove(doc, fromTokens); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, tokens, value)
+	}
+
+	switch name {
+	case "add":
+		return pointerAdd(doc, tokens, value)
+	case "remove":
+		return pointerRemove(doc, tokens)
+	case "replace":
+		if len(tokens) == 0 {
+			return value, nil
+		}
+		if doc, err = pointerRemove(doc, tokens); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, tokens, value)
+	case "test":
+		current, err := pointerGet(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !valuesEqual(current, value, &equalConfig{}) {
+			return nil, ErrPatchTestFailed
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("%w: unknown op %q", ErrInvalidPatch, name)
+}
+
+// DiffPatch returns an RFC 6902 JSON patch that turns from into to when
+// applied with ApplyPatch. It holds the changes of JSONDiff as add, remove
+// and replace operations. Nil objects are treated as empty objects.
+func DiffPatch(from, to JSON) ([]byte, error) {
+	ops := []map[string]interface{}{}
+	for _, c := range diffJSON(from, to) {
+		op := map[string]interface{}{"path": formatPointer(c.path)}
+		switch c.op {
+		case ChangeAdd:
+			op["op"], op["value"] = "add", c.new
+		case ChangeRemove:
+			op["op"] = "remove"
+		default:
+			op["op"], op["value"] = "replace", c.new
+		}
+		ops = append(ops, op)
+	}
+	return json.Marshal(ops)
+}
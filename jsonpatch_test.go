@@ -0,0 +1,185 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONApplyPatch(t *testing.T) {
+	const doc = `{"title":"Invoice","items":[{"sku":"a","qty":1},{"sku":"b","qty":2}],"a/b":1,"m~n":2,"meta":{"tags":["x","y"]}}`
+
+	tests := []struct {
+		name  string
+		patch string
+		want  string
+	}{
+		{
+			name:  "add member",
+			patch: `[{"op":"add","path":"/status","value":"paid"}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"meta":{"tags":["x","y"]},"m~n":2,"status":"paid","title":"Invoice"}`,
+		},
+		{
+			name:  "add replaces existing member",
+			patch: `[{"op":"add","path":"/title","value":null}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"meta":{"tags":["x","y"]},"m~n":2,"title":null}`,
+		},
+		{
+			name:  "insert array element",
+			patch: `[{"op":"add","path":"/meta/tags/1","value":"z"}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"meta":{"tags":["x","z","y"]},"m~n":2,"title":"Invoice"}`,
+		},
+		{
+			name:  "append array element",
+			patch: `[{"op":"add","path":"/meta/tags/-","value":"z"},{"op":"add","path":"/meta/tags/3","value":"w"}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"meta":{"tags":["x","y","z","w"]},"m~n":2,"title":"Invoice"}`,
+		},
+		{
+			name:  "remove array element",
+			patch: `[{"op":"remove","path":"/items/0"}]`,
+			want:  `{"a/b":1,"items":[{"qty":2,"sku":"b"}],"meta":{"tags":["x","y"]},"m~n":2,"title":"Invoice"}`,
+		},
+		{
+			name:  "replace nested member",
+			patch: `[{"op":"replace","path":"/items/1/qty","value":5}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":5,"sku":"b"}],"meta":{"tags":["x","y"]},"m~n":2,"title":"Invoice"}`,
+		},
+		{
+			name:  "escaped keys",
+			patch: `[{"op":"replace","path":"/a~1b","value":10},{"op":"remove","path":"/m~0n"}]`,
+			want:  `{"a/b":10,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"meta":{"tags":["x","y"]},"title":"Invoice"}`,
+		},
+		{
+			name:  "move",
+			patch: `[{"op":"move","from":"/meta/tags","path":"/tags"},{"op":"move","from":"/items/1","path":"/items/0"}]`,
+			want:  `{"a/b":1,"items":[{"qty":2,"sku":"b"},{"qty":1,"sku":"a"}],"meta":{},"m~n":2,"tags":["x","y"],"title":"Invoice"}`,
+		},
+		{
+			name:  "copy",
+			patch: `[{"op":"copy","from":"/items/0","path":"/items/-"},{"op":"replace","path":"/items/2/sku","value":"c"}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"},{"qty":1,"sku":"c"}],"meta":{"tags":["x","y"]},"m~n":2,"title":"Invoice"}`,
+		},
+		{
+			name:  "test passes",
+			patch: `[{"op":"test","path":"/items/1","value":{"sku":"b","qty":2.0}},{"op":"test","path":"/a~1b","value":1},{"op":"remove","path":"/meta"}]`,
+			want:  `{"a/b":1,"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"m~n":2,"title":"Invoice"}`,
+		},
+		{
+			name:  "replace root",
+			patch: `[{"op":"replace","path":"","value":{"a":1}}]`,
+			want:  `{"a":1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			j := mustJSON(t, doc)
+			got, err := j.ApplyPatch([]byte(tt.patch))
+			if err != nil {
+				t.Fatalf("ApplyPatch() returned error: %v", err)
+			}
+			if s := jsonString(t, got); s != tt.want {
+				t.Errorf("ApplyPatch() = %s, want %s", s, tt.want)
+			}
+			if s := jsonString(t, j); s != jsonString(t, mustJSON(t, doc)) {
+				t.Errorf("ApplyPatch() modified the document to %s", s)
+			}
+		})
+	}
+}
+
+func TestJSONApplyPatchErrors(t *testing.T) {
+	const doc = `{"title":"Invoice","items":[1,2]}`
+
+	tests := []struct {
+		patch string
+		want  error
+	}{
+		{`[{"op":"add","path":"/title","value":"x"},{"op":"test","path":"/title","value":"Invoice"}]`, dbtypes.ErrPatchTestFailed},
+		{`[{"op":"test","path":"/items","value":[1]}]`, dbtypes.ErrPatchTestFailed},
+		{`[{"op":"remove","path":"/missing"}]`, dbtypes.ErrKeyNotFound},
+		{`[{"op":"replace","path":"/missing","value":1}]`, dbtypes.ErrKeyNotFound},
+		{`[{"op":"add","path":"/missing/a","value":1}]`, dbtypes.ErrKeyNotFound},
+		{`[{"op":"add","path":"/items/3","value":1}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"add","path":"/items/01","value":1}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"remove","path":"/items/-"}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"remove","path":"/items/2"}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"add","path":"title","value":1}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"add","path":"/a~2b","value":1}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"remove","path":""}]`, dbtypes.ErrInvalidPath},
+		{`[{"op":"add","path":"/title/x","value":1}]`, dbtypes.ErrPathConflict},
+		{`[{"op":"move","from":"/items","path":"/items/0"}]`, dbtypes.ErrInvalidPatch},
+		{`[{"op":"add","path":"/x"}]`, dbtypes.ErrInvalidPatch},
+		{`[{"op":"copy","path":"/x"}]`, dbtypes.ErrInvalidPatch},
+		{`[{"op":"frobnicate","path":"/x"}]`, dbtypes.ErrInvalidPatch},
+		{`[{"path":"/x"}]`, dbtypes.ErrInvalidPatch},
+		{`{"op":"remove","path":"/title"}`, dbtypes.ErrInvalidPatch},
+		{`[{"op":"replace","path":"","value":[1]}]`, dbtypes.ErrNotObject},
+	}
+
+	for _, tt := range tests {
+		j := mustJSON(t, doc)
+		got, err := j.ApplyPatch([]byte(tt.patch))
+		if !errors.Is(err, tt.want) {
+			t.Errorf("ApplyPatch(%s) error = %v, want %v", tt.patch, err, tt.want)
+		}
+		if got != nil {
+			t.Errorf("ApplyPatch(%s) = %v, want nil", tt.patch, got)
+		}
+		if s := jsonString(t, j); s != jsonString(t, mustJSON(t, doc)) {
+			t.Errorf("ApplyPatch(%s) modified the document to %s", tt.patch, s)
+		}
+	}
+}
+
+func TestDiffPatch(t *testing.T) {
+	tests := []struct {
+		from, to string
+	}{
+		{`{}`, `{}`},
+		{`{"a":1}`, `{"a":1.0}`},
+		{`{"a":1,"b":"x"}`, `{"b":"y","c":[1,2]}`},
+		{`{"items":[1,2,3,4]}`, `{"items":[1,5]}`},
+		{`{"items":[{"qty":1}]}`, `{"items":[{"qty":2},{"qty":3},null]}`},
+		{`{"a/b":{"m~n":1}}`, `{"a/b":{"m~n":2,"~":{}}}`},
+		{`{"a":{"b":1}}`, `{"a":[1]}`},
+		{`{"a":null}`, `{"a":{"b":null}}`},
+	}
+
+	for _, tt := range tests {
+		from, to := mustJSON(t, tt.from), mustJSON(t, tt.to)
+		patch, err := dbtypes.DiffPatch(from, to)
+		if err != nil {
+			t.Errorf("DiffPatch(%s, %s) returned error: %v", tt.from, tt.to, err)
+			continue
+		}
+
+		got, err := from.ApplyPatch(patch)
+		if err != nil {
+			t.Errorf("ApplyPatch(%s) returned error: %v", patch, err)
+			continue
+		}
+		if jsonString(t, got) != jsonString(t, to) {
+			t.Errorf("DiffPatch(%s, %s) = %s, which applies to %s", tt.from, tt.to, patch, jsonString(t, got))
+		}
+	}
+}
+
+func TestDiffPatchMinimal(t *testing.T) {
+	from := mustJSON(t, `{"a":1,"b":[1,2,3],"c":{"d":true}}`)
+	to := mustJSON(t, `{"a":1,"b":[1,2],"c":{"d":false},"e":"x"}`)
+	want := `[{"op":"remove","path":"/b/2"},{"op":"replace","path":"/c/d","value":false},{"op":"add","path":"/e","value":"x"}]`
+
+	patch, err := dbtypes.DiffPatch(from, to)
+	if err != nil {
+		t.Fatalf("DiffPatch() returned error: %v", err)
+	}
+	if string(patch) != want {
+		t.Errorf("DiffPatch() = %s, want %s", patch, want)
+	}
+
+	if patch, _ := dbtypes.DiffPatch(nil, nil); string(patch) != "[]" {
+		t.Errorf("DiffPatch(nil, nil) = %s, want []", patch)
+	}
+}
@@ -0,0 +1,306 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPath is returned for malformed JSON paths and array indices
+	// past the end of an array.
+	ErrInvalidPath = errors.New("invalid JSON path")
+
+	// ErrPathConflict is returned when a path goes through a value that is
+	// not an object or array, e.g. "title.length" where title is a string.
+	ErrPathConflict = errors.New("JSON path goes through a non-container value")
+)
+
+// pathSegment is an object key or, if isIndex is set, an array index.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath parses a path into its segments, unescaping "\.", "\[" and "\\".
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	var key strings.Builder
+	afterIndex := false
+
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if afterIndex && c != '.' && c != '[' {
+			return nil, ErrInvalidPath
+		}
+
+		switch {
+		case c == '\\' && i+1 < len(path) && strings.IndexByte(`.[\`, path[i+1]) >= 0:
+			i++
+			key.WriteByte(path[i])
+		case c == '.':
+			if !afterIndex {
+				segments = append(segments, pathSegment{key: key.String()})
+				key.Reset()
+			}
+			afterIndex = false
+		case c == '[':
+			if !afterIndex {
+				if len(segments) == 0 && key.Len() == 0 {
+					return nil, ErrInvalidPath
+				}
+				segments = append(segments, pathSegment{key: key.String()})
+				key.Reset()
+			}
+
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 || !isDigits(path[i+1:i+end]) {
+				return nil, ErrInvalidPath
+			}
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil {
+				return nil, ErrInvalidPath
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			i += end
+			afterIndex = true
+		default:
+			key.WriteByte(c)
+		}
+	}
+
+	if !afterIndex {
+		segments = append(segments, pathSegment{key: key.String()})
+	}
+	return segments, nil
+}
+
+// Get returns the value at path, a dot-separated list of keys into nested
+// objects like "meta.author.name", with array indices in brackets like
+// "items[2].price". Dots, brackets and backslashes within a key are escaped
+// with a backslash, so "a\.b" is the single key "a.b".
+// ok is false if the path is malformed, any key or index is missing or an
+// intermediate value is not an object or array.
+func (j JSON) Get(path string) (value interface{}, ok bool) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var current interface{} = map[string]interface{}(j)
+	for _, seg := range segments {
+		if seg.isIndex {
+			arr, isArr := current.([]interface{})
+			if !isArr || seg.index >= len(arr) {
+				return nil, false
+			}
+			current = arr[seg.index]
+			continue
+		}
+
+		obj, isObj := asObject(current)
+		if !isObj {
+			return nil, false
+		}
+		if current, ok = obj[seg.key]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// asObject returns v as a map if it is a JSON object.
+func asObject(v interface{}) (map[string]interface{}, bool) {
+	switch obj := v.(type) {
+	case map[string]interface{}:
+		return obj, obj != nil
+	case JSON:
+		return obj, obj != nil
+	}
+	return nil, false
+}
+
+// Set sets the value at path (see Get), creating intermediate objects for
+// missing or null keys and initializing j if it is nil. An index may address
+// an existing array element or the one just past the end, which appends.
+//
+// It returns an error wrapping ErrInvalidPath for malformed paths and indices
+// further past the end of an array, or ErrPathConflict if the path goes
+// through an existing value of the wrong kind, e.g. a string; in that case
+// j is left unchanged.
+func (j *JSON) Set(path string, value interface{}) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return fmt.Errorf("%q: %w", path, err)
+	}
+
+	// Validate the whole path first so that a conflict leaves j unchanged.
+	if err := checkSetPath(map[string]interface{}(*j), segments); err != nil {
+		return fmt.Errorf("%q: %w", path, err)
+	}
+
+	if *j == nil {
+		*j = JSON{}
+	}
+	setPath(map[string]interface{}(*j), segments, value)
+	return nil
+}
+
+// checkSetPath reports whether setPath can set segments in node.
+func checkSetPath(node interface{}, segments []pathSegment) error {
+	for i, seg := range segments {
+		if node == nil {
+			// The rest of the path is created, so arrays start out empty.
+			for _, seg := range segments[i:] {
+				if seg.isIndex && seg.index != 0 {
+					return ErrInvalidPath
+				}
+			}
+			return nil
+		}
+
+		if seg.isIndex {
+			arr, ok := node.([]interface{})
+			if !ok {
+				return ErrPathConflict
+			}
+			if seg.index > len(arr) {
+				return ErrInvalidPath
+			}
+			if seg.index == len(arr) {
+				node = nil
+				continue
+			}
+			node = arr[seg.index]
+			continue
+		}
+
+		obj, ok := node.(map[string]interface{})
+		if !ok {
+			if obj, ok = node.(JSON); !ok {
+				return ErrPathConflict
+			}
+		}
+		node = obj[seg.key]
+	}
+	return nil
+}
+
+// setPath sets value at segments in node, creating missing containers,
+// and returns the updated node. The path must have been checked with checkSetPath.
+func setPath(node interface{}, segments []pathSegment, value interface{}) interface{} {
+	if len(segments) == 0 {
+		return value
+	}
+
+	seg := segments[0]
+	if seg.isIndex {
+		arr, _ := node.([]interface{})
+		if seg.index == len(arr) {
+			arr = append(arr, nil)
+		}
+		arr[seg.index] = setPath(arr[seg.index], segments[1:], value)
+		return arr
+	}
+
+	obj, ok := asObject(node)
+	if !ok {
+		obj = map[string]interface{}{}
+	}
+	obj[seg.key] = setPath(obj[seg.key], segments[1:], value)
+	return obj
+}
+
+// Delete removes the value at path (see Get) and reports whether it existed.
+// Deleting an array element shifts the following elements down.
+func (j JSON) Delete(path string) bool {
+	segments, err := parsePath(path)
+	if err != nil {
+		return false
+	}
+
+	_, deleted := deletePath(map[string]interface{}(j), segments)
+	return deleted
+}
+
+// deletePath removes the value at segments in node
+// and returns the updated node and whether a value was removed.
+func deletePath(node interface{}, segments []pathSegment) (interface{}, bool) {
+	seg := segments[0]
+	if seg.isIndex {
+		arr, ok := node.([]interface{})
+		if !ok || seg.index >= len(arr) {
+			return node, false
+		}
+		if len(segments) == 1 {
+			return append(arr[:seg.index:seg.index], arr[seg.index+1:]...), true
+		}
+
+		child, deleted := deletePath(arr[seg.index], segments[1:])
+		arr[seg.index] = child
+		return arr, deleted
+	}
+
+	obj, ok := asObject(node)
+	if !ok {
+		return node, false
+	}
+	child, exists := obj[seg.key]
+	if !exists {
+		return node, false
+	}
+	if len(segments) == 1 {
+		delete(obj, seg.key)
+		return obj, true
+	}
+
+	child, deleted := deletePath(child, segments[1:])
+	obj[seg.key] = child
+	return obj, deleted
+}
+
+// GetString returns the string at path.
+// ok is false if the path is missing or the value is not a string.
+func (j JSON) GetString(path string) (string, bool) {
+	v, _ := j.Get(path)
+	s, ok := v.(string)
+	return s, ok
+}
+
+// GetBool returns the boolean at path.
+// ok is false if the path is missing or the value is not a boolean.
+func (j JSON) GetBool(path string) (bool, bool) {
+	v, _ := j.Get(path)
+	b, ok := v.(bool)
+	return b, ok
+}
+
+// GetFloat64 returns the number at path as a float64. It understands
+// float64, json.Number (see JSONUseNumber) and Go integer types.
+// ok is false if the path is missing or the value is not a number.
+// Strings holding numbers are not converted.
+func (j JSON) GetFloat64(path string) (float64, bool) {
+	v, _ := j.Get(path)
+	return toFloat64(v)
+}
+
+// toFloat64 returns v as a float64 if it is a number, see GetFloat64.
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float32:
+		return float64(n), true
+	}
+
+	if i, err := toInt64(v); err == nil {
+		return float64(i), true
+	}
+	return 0, false
+}
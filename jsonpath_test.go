@@ -0,0 +1,278 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func pathFixture(t *testing.T) dbtypes.JSON {
+	t.Helper()
+
+	var j dbtypes.JSON
+	err := j.Scan(`{
+		"meta": {"author": {"name": "doc", "age": 65, "active": true}, "tags": ["a", "b"]},
+		"a.b": {"c": "dotted"},
+		"back\\slash": "escaped",
+		"price": 19.99,
+		"count": 3,
+		"title": "Back to the Future",
+		"empty": null
+	}`)
+	if err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	return j
+}
+
+func TestJSONGet(t *testing.T) {
+	j := pathFixture(t)
+
+	tests := []struct {
+		path   string
+		want   interface{}
+		wantOK bool
+	}{
+		{path: "title", want: "Back to the Future", wantOK: true},
+		{path: "meta.author.name", want: "doc", wantOK: true},
+		{path: `a\.b.c`, want: "dotted", wantOK: true},
+		{path: `back\\slash`, want: "escaped", wantOK: true},
+		{path: "empty", want: nil, wantOK: true},
+		{path: "a.b.c", wantOK: false},
+		{path: "meta.author.missing", wantOK: false},
+		{path: "title.length", wantOK: false},
+		{path: "meta.tags.0", wantOK: false},
+		{path: "empty.key", wantOK: false},
+		{path: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := j.Get(tt.path)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("Get(%q) = %v, %v, want %v, %v", tt.path, got, ok, tt.want, tt.wantOK)
+		}
+	}
+
+	var nilJSON dbtypes.JSON
+	if got, ok := nilJSON.Get("a.b"); ok || got != nil {
+		t.Errorf("nil Get() = %v, %v, want nil, false", got, ok)
+	}
+}
+
+func TestJSONTypedGetters(t *testing.T) {
+	j := pathFixture(t)
+
+	if got, ok := j.GetString("meta.author.name"); !ok || got != "doc" {
+		t.Errorf(`GetString("meta.author.name") = %q, %v`, got, ok)
+	}
+	if got, ok := j.GetString("price"); ok {
+		t.Errorf(`GetString("price") = %q, %v, want false`, got, ok)
+	}
+	if got, ok := j.GetBool("meta.author.active"); !ok || !got {
+		t.Errorf(`GetBool("meta.author.active") = %v, %v`, got, ok)
+	}
+	if got, ok := j.GetBool("title"); ok {
+		t.Errorf(`GetBool("title") = %v, %v, want false`, got, ok)
+	}
+	if got, err := j.GetInt64("meta.author.age"); err != nil || got != 65 {
+		t.Errorf(`GetInt64("meta.author.age") = %d, %v`, got, err)
+	}
+	if _, err := j.GetInt64("price"); err == nil {
+		t.Errorf(`GetInt64("price") error = nil, want error`)
+	}
+
+	var nilJSON dbtypes.JSON
+	if _, ok := nilJSON.GetString("a"); ok {
+		t.Errorf("nil GetString() ok = true")
+	}
+	if _, ok := nilJSON.GetFloat64("a"); ok {
+		t.Errorf("nil GetFloat64() ok = true")
+	}
+}
+
+func TestJSONGetFloat64(t *testing.T) {
+	j := dbtypes.JSON{
+		"float":   19.99,
+		"number":  json.Number("19.99"),
+		"bignum":  json.Number("9007199254740993"),
+		"int":     3,
+		"int64":   int64(-4),
+		"string":  "19.99",
+		"bool":    true,
+		"nested":  map[string]interface{}{"n": 1.5},
+		"invalid": json.Number("abc"),
+	}
+
+	tests := []struct {
+		path   string
+		want   float64
+		wantOK bool
+	}{
+		{path: "float", want: 19.99, wantOK: true},
+		{path: "number", want: 19.99, wantOK: true},
+		{path: "bignum", want: 9007199254740992, wantOK: true},
+		{path: "int", want: 3, wantOK: true},
+		{path: "int64", want: -4, wantOK: true},
+		{path: "nested.n", want: 1.5, wantOK: true},
+		{path: "string", wantOK: false},
+		{path: "bool", wantOK: false},
+		{path: "invalid", wantOK: false},
+		{path: "missing", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := j.GetFloat64(tt.path)
+		if ok != tt.wantOK || (ok && got != tt.want) {
+			t.Errorf("GetFloat64(%q) = %v, %v, want %v, %v", tt.path, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestJSONSetBuildsDocument(t *testing.T) {
+	var j dbtypes.JSON
+	sets := []struct {
+		path  string
+		value interface{}
+	}{
+		{path: "meta.author.name", value: "doc"},
+		{path: "meta.author.active", value: true},
+		{path: `meta.a\.b`, value: "dotted"},
+		{path: "items[0].name", value: "flux capacitor"},
+		{path: "items[0].price", value: 1.21},
+		{path: "items[1].name", value: "hoverboard"},
+		{path: "items[1].tags[0]", value: "pink"},
+		{path: "items[1].price", value: 88.0},
+		{path: "items[0].price", value: 2.42},
+		{path: "count", value: 2},
+	}
+
+	for _, tt := range sets {
+		if err := j.Set(tt.path, tt.value); err != nil {
+			t.Fatalf("Set(%q) returned error: %v", tt.path, err)
+		}
+	}
+
+	if got, ok := j.GetString("meta.author.name"); !ok || got != "doc" {
+		t.Errorf(`GetString("meta.author.name") = %q, %v`, got, ok)
+	}
+	if got, ok := j.GetBool("meta.author.active"); !ok || !got {
+		t.Errorf(`GetBool("meta.author.active") = %v, %v`, got, ok)
+	}
+	if got, ok := j.GetString(`meta.a\.b`); !ok || got != "dotted" {
+		t.Errorf(`GetString("meta.a\.b") = %q, %v`, got, ok)
+	}
+	if got, ok := j.GetFloat64("items[0].price"); !ok || got != 2.42 {
+		t.Errorf(`GetFloat64("items[0].price") = %v, %v`, got, ok)
+	}
+	if got, ok := j.GetString("items[1].tags[0]"); !ok || got != "pink" {
+		t.Errorf(`GetString("items[1].tags[0]") = %q, %v`, got, ok)
+	}
+	if got, err := j.GetInt64("count"); err != nil || got != 2 {
+		t.Errorf(`GetInt64("count") = %d, %v`, got, err)
+	}
+
+	data, err := json.Marshal(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"count":2,"items":[{"name":"flux capacitor","price":2.42},{"name":"hoverboard","price":88,"tags":["pink"]}],"meta":{"a.b":"dotted","author":{"active":true,"name":"doc"}}}`
+	if string(data) != want {
+		t.Errorf("document = %s, want %s", data, want)
+	}
+}
+
+func TestJSONSetErrors(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr error
+	}{
+		{path: "title.length", wantErr: dbtypes.ErrPathConflict},
+		{path: "tags.first", wantErr: dbtypes.ErrPathConflict},
+		{path: "meta[0]", wantErr: dbtypes.ErrPathConflict},
+		{path: "tags[5]", wantErr: dbtypes.ErrInvalidPath},
+		{path: "missing[1]", wantErr: dbtypes.ErrInvalidPath},
+		{path: "tags[2][1]", wantErr: dbtypes.ErrInvalidPath},
+		{path: "tags[x]", wantErr: dbtypes.ErrInvalidPath},
+		{path: "tags[1", wantErr: dbtypes.ErrInvalidPath},
+		{path: "tags[1]x", wantErr: dbtypes.ErrInvalidPath},
+		{path: "[0]", wantErr: dbtypes.ErrInvalidPath},
+	}
+
+	for _, tt := range tests {
+		j := dbtypes.JSON{"title": "Back to the Future", "tags": []interface{}{"a", "b"}, "meta": map[string]interface{}{}}
+		before, _ := json.Marshal(j)
+
+		err := j.Set(tt.path, "value")
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Set(%q) error = %v, want %v", tt.path, err, tt.wantErr)
+		}
+		if after, _ := json.Marshal(j); string(after) != string(before) {
+			t.Errorf("Set(%q) modified the document: %s", tt.path, after)
+		}
+	}
+}
+
+func TestJSONSetNullIntermediate(t *testing.T) {
+	j := dbtypes.JSON{"meta": nil, "tags": []interface{}{"a"}}
+	if err := j.Set("meta.author", "doc"); err != nil {
+		t.Fatalf("Set() through null returned error: %v", err)
+	}
+	if err := j.Set("tags[1]", "b"); err != nil {
+		t.Fatalf("Set() appending returned error: %v", err)
+	}
+
+	if got, ok := j.GetString("meta.author"); !ok || got != "doc" {
+		t.Errorf(`GetString("meta.author") = %q, %v`, got, ok)
+	}
+	if got, ok := j.GetString("tags[1]"); !ok || got != "b" {
+		t.Errorf(`GetString("tags[1]") = %q, %v`, got, ok)
+	}
+}
+
+func TestJSONDelete(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+		doc  string
+	}{
+		{path: "title", want: true, doc: `{"items":[{"name":"a","price":1},{"name":"b"}],"meta":{"author":"doc"}}`},
+		{path: "meta.author", want: true, doc: `{"items":[{"name":"a","price":1},{"name":"b"}],"meta":{},"title":"t"}`},
+		{path: "items[0].price", want: true, doc: `{"items":[{"name":"a"},{"name":"b"}],"meta":{"author":"doc"},"title":"t"}`},
+		{path: "items[0]", want: true, doc: `{"items":[{"name":"b"}],"meta":{"author":"doc"},"title":"t"}`},
+		{path: "items[2]", want: false},
+		{path: "meta.missing", want: false},
+		{path: "title.length", want: false},
+		{path: "items[", want: false},
+	}
+
+	for _, tt := range tests {
+		j := dbtypes.JSON{
+			"title": "t",
+			"meta":  map[string]interface{}{"author": "doc"},
+			"items": []interface{}{
+				map[string]interface{}{"name": "a", "price": 1},
+				map[string]interface{}{"name": "b"},
+			},
+		}
+		before, _ := json.Marshal(j)
+
+		if got := j.Delete(tt.path); got != tt.want {
+			t.Errorf("Delete(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+
+		want := tt.doc
+		if !tt.want {
+			want = string(before)
+		}
+		if after, _ := json.Marshal(j); string(after) != want {
+			t.Errorf("after Delete(%q) = %s, want %s", tt.path, after, want)
+		}
+	}
+
+	var nilJSON dbtypes.JSON
+	if nilJSON.Delete("a") {
+		t.Errorf("nil Delete() = true")
+	}
+}
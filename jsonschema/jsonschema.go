@@ -0,0 +1,48 @@
+// Package jsonschema validates dbtypes.JSON documents against JSON Schemas
+// compiled with github.com/santhosh-tekuri/jsonschema/v6.
+// It lives in its own package so that the core dbtypes package
+// does not depend on a JSON Schema implementation.
+package jsonschema
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"github.com/abiiranathan/dbtypes"
+	jsv "github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Validator returns a validator for dbtypes.SetJSONValidator that checks
+// documents against schema. The returned *dbtypes.JSONValidationError has
+// the JSON pointer of the first value violating the schema and wraps the
+// *jsonschema.ValidationError.
+func Validator(schema *jsv.Schema) func(data []byte) error {
+	return func(data []byte) error {
+		doc, err := jsv.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+
+		err = schema.Validate(doc)
+		var schemaErr *jsv.ValidationError
+		if !errors.As(err, &schemaErr) {
+			return err
+		}
+
+		// The leaves of the error tree are the violations, find the first one.
+		leaf := schemaErr
+		for len(leaf.Causes) > 0 {
+			leaf = leaf.Causes[0]
+		}
+
+		var path strings.Builder
+		for _, token := range leaf.InstanceLocation {
+			path.WriteByte('/')
+			path.WriteString(pointerEscaper.Replace(token))
+		}
+		return &dbtypes.JSONValidationError{Path: path.String(), Err: err}
+	}
+}
@@ -0,0 +1,100 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/abiiranathan/dbtypes/jsonschema"
+	jsv "github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+const invoiceSchema = `{
+	"type": "object",
+	"required": ["number"],
+	"properties": {
+		"number": {"type": "string"},
+		"items": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"required": ["sku"],
+				"properties": {"qty": {"type": "integer", "minimum": 1}}
+			}
+		}
+	}
+}`
+
+func setInvoiceValidator(t *testing.T) {
+	t.Helper()
+
+	doc, err := jsv.UnmarshalJSON(strings.NewReader(invoiceSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := jsv.NewCompiler()
+	if err := c.AddResource("invoice.json", doc); err != nil {
+		t.Fatal(err)
+	}
+	schema, err := c.Compile("invoice.json")
+	if err != nil {
+		t.Fatalf("Compile() returned error: %v", err)
+	}
+
+	dbtypes.SetJSONValidator(jsonschema.Validator(schema))
+	t.Cleanup(func() { dbtypes.SetJSONValidator(nil) })
+}
+
+func TestValidator(t *testing.T) {
+	setInvoiceValidator(t)
+
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr bool
+		path    string
+	}{
+		{"valid", `{"number":"INV-1","items":[{"sku":"a","qty":2}]}`, false, ""},
+		{"missing required key", `{"items":[]}`, true, ""},
+		{"wrong type", `{"number":42}`, true, "/number"},
+		{"nested", `{"number":"INV-1","items":[{"sku":"a"},{"sku":"b","qty":0}]}`, true, "/items/1/qty"},
+		{"nested missing key", `{"number":"INV-1","items":[{"qty":1}]}`, true, "/items/0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoders := map[string]func(*dbtypes.JSON) error{
+				"Scan":          func(j *dbtypes.JSON) error { return j.Scan([]byte(tt.doc)) },
+				"UnmarshalJSON": func(j *dbtypes.JSON) error { return json.Unmarshal([]byte(tt.doc), j) },
+				"FormScan":      func(j *dbtypes.JSON) error { return j.FormScan(tt.doc) },
+			}
+
+			for name, decode := range decoders {
+				var j dbtypes.JSON
+				err := decode(&j)
+				if !tt.wantErr {
+					if err != nil {
+						t.Errorf("%s() returned error: %v", name, err)
+					}
+					continue
+				}
+
+				var validationErr *dbtypes.JSONValidationError
+				if !errors.As(err, &validationErr) {
+					t.Errorf("%s() error = %v, want *JSONValidationError", name, err)
+					continue
+				}
+				if validationErr.Path != tt.path {
+					t.Errorf("%s() error path = %q, want %q", name, validationErr.Path, tt.path)
+				}
+
+				var schemaErr *jsv.ValidationError
+				if !errors.As(err, &schemaErr) {
+					t.Errorf("%s() error does not wrap *jsonschema.ValidationError", name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package dbtypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// JSONFromStruct converts src, typically a struct, to a JSON object
+// by marshaling it with encoding/json, so json tags are honored.
+// Numbers are decoded as configured with JSONUseNumber.
+// It returns an error wrapping ErrNotObject if src does not marshal
+// to an object, and nil for nil pointers and maps.
+func JSONFromStruct(src interface{}) (JSON, error) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := decodeJSON(data, &v); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, nil
+	}
+
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %T to JSON: %w", src, ErrNotObject)
+	}
+	return JSON(obj), nil
+}
+
+// ToStruct decodes j into dest, which must be a pointer, by marshaling
+// and unmarshaling it with encoding/json, so json tags are honored.
+// If a value has the wrong type, the error names its path, e.g.
+// "address.zip": json: cannot unmarshal string into Go struct field ...
+func (j JSON) ToStruct(dest interface{}) error {
+	return decodeInto(map[string]interface{}(j), "", dest)
+}
+
+// DecodePath decodes the value at path (see Get) into dest, which must be
+// a pointer, like ToStruct. It returns an error wrapping ErrKeyNotFound if
+// path is missing. Errors name the path of the value that failed to decode.
+func (j JSON) DecodePath(path string, dest interface{}) error {
+	v, ok := j.Get(path)
+	if !ok {
+		return fmt.Errorf("%q: %w", path, ErrKeyNotFound)
+	}
+	return decodeInto(v, path, dest)
+}
+
+// decodeInto decodes the JSON value v found at path into dest.
+func decodeInto(v interface{}, path string, dest interface{}) error {
+	data, err := json.Marshal(v)
+	if err == nil {
+		err = decodeJSON(data, dest)
+	}
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		if path != "" {
+			path += "."
+		}
+		path += typeErr.Field
+	}
+	if path == "" {
+		return err
+	}
+	return fmt.Errorf("%q: %w", path, err)
+}
@@ -0,0 +1,154 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+type structAddress struct {
+	Street string `json:"street"`
+	Zip    int    `json:"zip"`
+}
+
+type structCustomer struct {
+	Name     string            `json:"name"`
+	Email    string            `json:"email,omitempty"`
+	Age      int               `json:"age,omitempty"`
+	Address  structAddress     `json:"address"`
+	Previous []structAddress   `json:"previous,omitempty"`
+	Manager  *structCustomer   `json:"manager,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Internal string            `json:"-"`
+}
+
+func TestJSONFromStruct(t *testing.T) {
+	c := structCustomer{
+		Name:     "Ann",
+		Address:  structAddress{Street: "Main St", Zip: 256},
+		Previous: []structAddress{{Street: "Old Rd", Zip: 1}},
+		Manager:  &structCustomer{Name: "Bob"},
+		Internal: "secret",
+	}
+
+	j, err := dbtypes.JSONFromStruct(c)
+	if err != nil {
+		t.Fatalf("JSONFromStruct() returned error: %v", err)
+	}
+
+	want := `{"address":{"street":"Main St","zip":256},"manager":{"address":{"street":"","zip":0},"name":"Bob"},"name":"Ann","previous":[{"street":"Old Rd","zip":1}]}`
+	if got := jsonString(t, j); got != want {
+		t.Errorf("JSONFromStruct() = %s, want %s", got, want)
+	}
+	if j.Has("email") || j.Has("Internal") {
+		t.Errorf("JSONFromStruct() = %v, want omitempty and ignored fields left out", j)
+	}
+
+	if j, err := dbtypes.JSONFromStruct((*structCustomer)(nil)); j != nil || err != nil {
+		t.Errorf("JSONFromStruct(nil) = %v, %v, want nil, nil", j, err)
+	}
+	for _, src := range []interface{}{[]int{1}, "text", 42} {
+		if _, err := dbtypes.JSONFromStruct(src); !errors.Is(err, dbtypes.ErrNotObject) {
+			t.Errorf("JSONFromStruct(%v) error = %v, want ErrNotObject", src, err)
+		}
+	}
+	if _, err := dbtypes.JSONFromStruct(map[string]interface{}{"f": func() {}}); err == nil {
+		t.Errorf("JSONFromStruct() of a func returned no error")
+	}
+}
+
+func TestJSONToStruct(t *testing.T) {
+	j := mustJSON(t, `{"name":"Ann","age":30,"address":{"street":"Main St","zip":256},"previous":[{"zip":1}],"manager":{"name":"Bob"},"labels":{"tier":"gold"},"unknown":true}`)
+
+	var got structCustomer
+	if err := j.ToStruct(&got); err != nil {
+		t.Fatalf("ToStruct() returned error: %v", err)
+	}
+
+	want := structCustomer{
+		Name:     "Ann",
+		Age:      30,
+		Address:  structAddress{Street: "Main St", Zip: 256},
+		Previous: []structAddress{{Zip: 1}},
+		Manager:  &structCustomer{Name: "Bob"},
+		Labels:   map[string]string{"tier": "gold"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToStruct() = %+v, want %+v", got, want)
+	}
+
+	// Round trip through JSONFromStruct.
+	back, err := dbtypes.JSONFromStruct(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again structCustomer
+	if err := back.ToStruct(&again); err != nil || !reflect.DeepEqual(again, want) {
+		t.Errorf("round trip = %+v, %v, want %+v", again, err, want)
+	}
+}
+
+func TestJSONToStructErrors(t *testing.T) {
+	tests := []struct {
+		doc  string
+		path string
+	}{
+		{`{"name":1}`, `"name"`},
+		{`{"address":{"zip":"256"}}`, `"address.zip"`},
+		{`{"manager":{"address":{"street":[]}}}`, `"manager.address.street"`},
+	}
+
+	for _, tt := range tests {
+		var c structCustomer
+		err := mustJSON(t, tt.doc).ToStruct(&c)
+		if err == nil || !strings.HasPrefix(err.Error(), tt.path+": ") {
+			t.Errorf("ToStruct(%s) error = %v, want it to start with %s", tt.doc, err, tt.path)
+		}
+	}
+
+	// Newer Go versions include the array index in the field path.
+	var c structCustomer
+	err := mustJSON(t, `{"previous":[{"zip":1},{"zip":true}]}`).ToStruct(&c)
+	if err == nil || !strings.HasPrefix(err.Error(), `"previous.`) || !strings.Contains(err.Error(), `zip": `) {
+		t.Errorf("ToStruct() error = %v, want it to name previous.zip", err)
+	}
+
+	if err := mustJSON(t, `{}`).ToStruct(c); err == nil {
+		t.Errorf("ToStruct() into a non-pointer returned no error")
+	}
+}
+
+func TestJSONDecodePath(t *testing.T) {
+	j := mustJSON(t, `{"customer":{"name":"Ann","address":{"street":"Main St","zip":256}},"history":[{"street":"Old Rd","zip":"x"}]}`)
+
+	var addr structAddress
+	if err := j.DecodePath("customer.address", &addr); err != nil {
+		t.Fatalf("DecodePath() returned error: %v", err)
+	}
+	if want := (structAddress{Street: "Main St", Zip: 256}); addr != want {
+		t.Errorf("DecodePath() = %+v, want %+v", addr, want)
+	}
+
+	var name string
+	if err := j.DecodePath("customer.name", &name); err != nil || name != "Ann" {
+		t.Errorf("DecodePath() = %q, %v, want Ann", name, err)
+	}
+
+	err := j.DecodePath("customer.phone", &name)
+	if !errors.Is(err, dbtypes.ErrKeyNotFound) || !strings.Contains(err.Error(), `"customer.phone"`) {
+		t.Errorf("DecodePath() of a missing path error = %v, want ErrKeyNotFound naming the path", err)
+	}
+
+	err = j.DecodePath("history[0]", &addr)
+	if err == nil || !strings.HasPrefix(err.Error(), `"history[0].zip": `) {
+		t.Errorf("DecodePath() error = %v, want it to name history[0].zip", err)
+	}
+
+	err = j.DecodePath("customer", &name)
+	if err == nil || !strings.HasPrefix(err.Error(), `"customer": `) {
+		t.Errorf("DecodePath() error = %v, want it to name customer", err)
+	}
+}
@@ -0,0 +1,93 @@
+package dbtypes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrUnexpectedType is returned when a JSON value has a type that
+// cannot be converted, e.g. a boolean where a time is expected.
+var ErrUnexpectedType = errors.New("JSON value has an unexpected type")
+
+// GetTime returns the value at path (see Get) as a time. Strings are parsed
+// as RFC 3339 timestamps, with or without fractional seconds, and numbers
+// are Unix times in seconds, possibly fractional, returned in UTC.
+// ok is false if the path is missing, has another type or cannot be parsed;
+// LookupTime reports why.
+func (j JSON) GetTime(path string) (time.Time, bool) {
+	t, err := j.LookupTime(path)
+	return t, err == nil
+}
+
+// LookupTime is like GetTime but returns an error wrapping ErrKeyNotFound if
+// path is missing, ErrUnexpectedType if the value is not a string or number,
+// or the error from parsing the string.
+func (j JSON) LookupTime(path string) (time.Time, error) {
+	v, ok := j.Get(path)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%q: %w", path, ErrKeyNotFound)
+	}
+
+	t, err := jsonTime(v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q: %w", path, err)
+	}
+	return t, nil
+}
+
+// jsonTime converts a decoded JSON string or number to a time, see GetTime.
+func jsonTime(v interface{}) (time.Time, error) {
+	if s, ok := v.(string); ok {
+		return time.Parse(time.RFC3339, s)
+	}
+
+	if sec, err := toInt64(v); err == nil {
+		return time.Unix(sec, 0).UTC(), nil
+	}
+	f, ok := toFloat64(v)
+	if !ok {
+		return time.Time{}, fmt.Errorf("cannot convert %T to a time: %w", v, ErrUnexpectedType)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return time.Time{}, fmt.Errorf("Unix time %v: %w", f, ErrDateOutOfRange)
+	}
+
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(math.Round(frac*1e9))).UTC(), nil
+}
+
+// GetDate returns the value at path (see Get) as a date. Strings are parsed
+// with ParseDateAny and its default layouts, and numbers are Unix times in
+// seconds whose UTC date is returned.
+// ok is false if the path is missing, has another type or cannot be parsed;
+// LookupDate reports why.
+func (j JSON) GetDate(path string) (Date, bool) {
+	date, err := j.LookupDate(path)
+	return date, err == nil
+}
+
+// LookupDate is like GetDate but returns an error wrapping ErrKeyNotFound if
+// path is missing, ErrUnexpectedType if the value is not a string or number,
+// or the *ParseError from parsing the string.
+func (j JSON) LookupDate(path string) (Date, error) {
+	v, ok := j.Get(path)
+	if !ok {
+		return Date{}, fmt.Errorf("%q: %w", path, ErrKeyNotFound)
+	}
+
+	if s, ok := v.(string); ok {
+		date, _, err := ParseDateAny(s)
+		if err != nil {
+			return Date{}, fmt.Errorf("%q: %w", path, err)
+		}
+		return date, nil
+	}
+
+	t, err := jsonTime(v)
+	if err != nil {
+		return Date{}, fmt.Errorf("%q: %w", path, err)
+	}
+	return DateFromTime(t), nil
+}
@@ -0,0 +1,125 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONGetTime(t *testing.T) {
+	j := mustJSON(t, `{
+		"created": "2015-10-21T07:28:00Z",
+		"updated": "2015-10-21T07:28:00.123456789+03:00",
+		"epoch": 1445412480,
+		"epoch_frac": 1445412480.25,
+		"negative": -1.5,
+		"nested": {"events": [{"at": "2020-02-29T00:00:00Z"}]},
+		"date_only": "2015-10-21",
+		"bad": "yesterday",
+		"flag": true,
+		"none": null
+	}`)
+	j["number"] = json.Number("1445412480")
+	j["int"] = int64(0)
+
+	tests := []struct {
+		path   string
+		want   time.Time
+		wantOK bool
+	}{
+		{"created", time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC), true},
+		{"updated", time.Date(2015, 10, 21, 4, 28, 0, 123456789, time.UTC), true},
+		{"epoch", time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC), true},
+		{"epoch_frac", time.Date(2015, 10, 21, 7, 28, 0, 250000000, time.UTC), true},
+		{"negative", time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC), true},
+		{"number", time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC), true},
+		{"int", time.Unix(0, 0).UTC(), true},
+		{"nested.events[0].at", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), true},
+		{"date_only", time.Time{}, false},
+		{"bad", time.Time{}, false},
+		{"flag", time.Time{}, false},
+		{"none", time.Time{}, false},
+		{"missing", time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := j.GetTime(tt.path)
+		if ok != tt.wantOK || !got.Equal(tt.want) {
+			t.Errorf("GetTime(%q) = %v, %v, want %v, %v", tt.path, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestJSONLookupTimeErrors(t *testing.T) {
+	j := mustJSON(t, `{"bad":"yesterday","flag":true,"huge":1e300}`)
+
+	var parseErr *time.ParseError
+	if _, err := j.LookupTime("bad"); !errors.As(err, &parseErr) {
+		t.Errorf("LookupTime(bad) error = %v, want *time.ParseError", err)
+	}
+	if _, err := j.LookupTime("flag"); !errors.Is(err, dbtypes.ErrUnexpectedType) {
+		t.Errorf("LookupTime(flag) error = %v, want ErrUnexpectedType", err)
+	}
+	if _, err := j.LookupTime("huge"); !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+		t.Errorf("LookupTime(huge) error = %v, want ErrDateOutOfRange", err)
+	}
+	if _, err := j.LookupTime("missing"); !errors.Is(err, dbtypes.ErrKeyNotFound) {
+		t.Errorf("LookupTime(missing) error = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestJSONGetDate(t *testing.T) {
+	j := mustJSON(t, `{
+		"iso": "2015-10-21",
+		"timestamp": "2015-10-21T23:28:00Z",
+		"slashes": "21/10/2015",
+		"named": "Oct 21, 2015",
+		"epoch": 1445412480,
+		"invoice": {"due": "20151021"},
+		"invalid": "2015-02-30",
+		"flag": false
+	}`)
+	want := dbtypes.NewDateUTC(2015, time.October, 21)
+
+	tests := []struct {
+		path   string
+		wantOK bool
+	}{
+		{"iso", true},
+		{"timestamp", true},
+		{"slashes", true},
+		{"named", true},
+		{"epoch", true},
+		{"invoice.due", true},
+		{"invalid", false},
+		{"flag", false},
+		{"missing", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := j.GetDate(tt.path)
+		if ok != tt.wantOK {
+			t.Errorf("GetDate(%q) ok = %v, want %v", tt.path, ok, tt.wantOK)
+			continue
+		}
+		if ok && got.String() != want.String() {
+			t.Errorf("GetDate(%q) = %s, want %s", tt.path, got, want)
+		}
+		if !ok && !got.IsZero() {
+			t.Errorf("GetDate(%q) = %s, want the zero date", tt.path, got)
+		}
+	}
+
+	if _, err := j.LookupDate("invalid"); !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+		t.Errorf("LookupDate(invalid) error = %v, want ErrDateOutOfRange", err)
+	}
+	if _, err := j.LookupDate("flag"); !errors.Is(err, dbtypes.ErrUnexpectedType) {
+		t.Errorf("LookupDate(flag) error = %v, want ErrUnexpectedType", err)
+	}
+	if _, err := j.LookupDate("missing"); !errors.Is(err, dbtypes.ErrKeyNotFound) {
+		t.Errorf("LookupDate(missing) error = %v, want ErrKeyNotFound", err)
+	}
+}
@@ -0,0 +1,106 @@
+package dbtypes
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSONType is the kind of a JSON value, see JSON.TypeOf.
+type JSONType int
+
+const (
+	JSONNull JSONType = iota
+	JSONBool
+	JSONNumber
+	JSONString
+	JSONObject
+	JSONArray
+
+	// JSONMissing is the type of paths without a value.
+	JSONMissing
+)
+
+// String returns the name of t, e.g. "object", as used by JSON Schema.
+func (t JSONType) String() string {
+	switch t {
+	case JSONNull:
+		return "null"
+	case JSONBool:
+		return "boolean"
+	case JSONNumber:
+		return "number"
+	case JSONString:
+		return "string"
+	case JSONObject:
+		return "object"
+	case JSONArray:
+		return "array"
+	case JSONMissing:
+		return "missing"
+	}
+	return "unknown"
+}
+
+// Exists reports whether j has a value at path (see Get), even if it is null.
+// It is the same as HasPath.
+func (j JSON) Exists(path string) bool {
+	return j.HasPath(path)
+}
+
+// TypeOf returns the type of the value at path (see Get), or JSONMissing
+// if there is none, so a member set to null is JSONNull while an absent
+// one is JSONMissing. Use Exists to only check for a value. Values that
+// are not decoded JSON, like structs or Dates, have the type of their JSON
+// encoding.
+func (j JSON) TypeOf(path string) JSONType {
+	v, ok := j.Get(path)
+	if !ok {
+		return JSONMissing
+	}
+	return typeOf(v)
+}
+
+// typeOf returns the type of the value v.
+func typeOf(v interface{}) JSONType {
+	switch v := v.(type) {
+	case nil:
+		return JSONNull
+	case bool:
+		return JSONBool
+	case string:
+		return JSONString
+	case []interface{}:
+		if v == nil {
+			return JSONNull
+		}
+		return JSONArray
+	case map[string]interface{}, JSON:
+		if _, ok := asObject(v); !ok {
+			return JSONNull
+		}
+		return JSONObject
+	}
+	if _, ok := toFloat64(v); ok {
+		return JSONNumber
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return JSONMissing
+	}
+	switch data = bytes.TrimSpace(data); {
+	case len(data) == 0:
+		return JSONMissing
+	case data[0] == '{':
+		return JSONObject
+	case data[0] == '[':
+		return JSONArray
+	case data[0] == '"':
+		return JSONString
+	case data[0] == 't' || data[0] == 'f':
+		return JSONBool
+	case data[0] == 'n':
+		return JSONNull
+	}
+	return JSONNumber
+}
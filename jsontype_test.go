@@ -0,0 +1,81 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestJSONTypeOf(t *testing.T) {
+	j := mustJSON(t, `{"name":"Ann","age":41,"admin":false,"manager":null,"tags":["a",null],"address":{"city":"Kampala"},"empty":{}}`)
+	j["date"] = dbtypes.NewDate(2024, time.March, 1)
+	j["zeroDate"] = dbtypes.Date{}
+	j["count"] = 3
+	j["big"] = json.Number("9007199254740993")
+	j["nilMap"] = map[string]interface{}(nil)
+
+	tests := []struct {
+		path   string
+		want   dbtypes.JSONType
+		exists bool
+	}{
+		{"name", dbtypes.JSONString, true},
+		{"age", dbtypes.JSONNumber, true},
+		{"admin", dbtypes.JSONBool, true},
+		{"manager", dbtypes.JSONNull, true},
+		{"tags", dbtypes.JSONArray, true},
+		{"tags[1]", dbtypes.JSONNull, true},
+		{"tags[2]", dbtypes.JSONMissing, false},
+		{"address", dbtypes.JSONObject, true},
+		{"address.city", dbtypes.JSONString, true},
+		{"address.zip", dbtypes.JSONMissing, false},
+		{"empty", dbtypes.JSONObject, true},
+		{"name.first", dbtypes.JSONMissing, false},
+		{"manager.name", dbtypes.JSONMissing, false},
+		{"missing", dbtypes.JSONMissing, false},
+		{"date", dbtypes.JSONString, true},
+		{"zeroDate", dbtypes.JSONNull, true},
+		{"count", dbtypes.JSONNumber, true},
+		{"big", dbtypes.JSONNumber, true},
+		{"nilMap", dbtypes.JSONNull, true},
+		{"tags[", dbtypes.JSONMissing, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := j.TypeOf(tt.path); got != tt.want {
+				t.Errorf("TypeOf(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+			if got := j.Exists(tt.path); got != tt.exists {
+				t.Errorf("Exists(%q) = %v, want %v", tt.path, got, tt.exists)
+			}
+			if got := j.HasPath(tt.path); got != tt.exists {
+				t.Errorf("HasPath(%q) = %v, want %v", tt.path, got, tt.exists)
+			}
+		})
+	}
+}
+
+func TestJSONTypeString(t *testing.T) {
+	tests := []struct {
+		typ  dbtypes.JSONType
+		want string
+	}{
+		{dbtypes.JSONNull, "null"},
+		{dbtypes.JSONBool, "boolean"},
+		{dbtypes.JSONNumber, "number"},
+		{dbtypes.JSONString, "string"},
+		{dbtypes.JSONObject, "object"},
+		{dbtypes.JSONArray, "array"},
+		{dbtypes.JSONMissing, "missing"},
+		{dbtypes.JSONType(-1), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.typ.String(); got != tt.want {
+			t.Errorf("JSONType(%d).String() = %q, want %q", int(tt.typ), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package dbtypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// JSONValidationError is returned when a JSON document is rejected by the
+// validator set with SetJSONValidator.
+type JSONValidationError struct {
+	Path string // JSON pointer to the offending value, "" for the whole document
+	Err  error  // The error returned by the validator
+}
+
+func (e *JSONValidationError) Error() string {
+	if e.Path == "" {
+		return "invalid JSON document: " + e.Err.Error()
+	}
+	return "invalid JSON document at " + e.Path + ": " + e.Err.Error()
+}
+
+func (e *JSONValidationError) Unwrap() error {
+	return e.Err
+}
+
+// jsonValidator validates JSON documents before they are decoded into JSON.
+var jsonValidator func(data []byte) error
+
+// SetJSONValidator sets a function that validates the JSON text of documents
+// before JSON.Scan, JSON.UnmarshalJSON and JSON.FormScan decode them, e.g.
+// the dbtypes/jsonschema package's Validator for a compiled JSON Schema.
+// Maps passed to Scan are marshaled to be validated. NULL, empty values and
+// null are not validated.
+//
+// Errors returned by validate are wrapped in a *JSONValidationError unless
+// they already are one. Pass nil to disable validation, which is the default.
+// This should be called once at program startup.
+func SetJSONValidator(validate func(data []byte) error) {
+	jsonValidator = validate
+}
+
+// validateJSON validates data with the validator set with SetJSONValidator.
+// Empty data and null are not validated.
+func validateJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if jsonValidator == nil || len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+
+	err := jsonValidator(data)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *JSONValidationError
+	if errors.As(err, &validationErr) {
+		return err
+	}
+	return &JSONValidationError{Err: err}
+}
+
+// validateJSONValue validates the decoded JSON object v, see validateJSON.
+func validateJSONValue(v map[string]interface{}) error {
+	if jsonValidator == nil || v == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return validateJSON(data)
+}
+
+// UnmarshalJSON decodes data into j like encoding/json does for maps,
+// honoring JSONUseNumber, after checking the limits set with SetMaxJSONSize and SetMaxJSONDepth
+// and validating it with the validator set with SetJSONValidator.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if err := checkJSONLimits(data); err != nil {
+		return err
+	}
+	if err := validateJSON(data); err != nil {
+		return err
+	}
+	return decodeJSON(data, (*map[string]interface{})(j))
+}
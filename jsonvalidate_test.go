@@ -0,0 +1,106 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// errNoNumber is returned by the validator set with setInvoiceValidator.
+var errNoNumber = errors.New("number is required")
+
+func setInvoiceValidator(t *testing.T) {
+	t.Helper()
+
+	dbtypes.SetJSONValidator(func(data []byte) error {
+		var doc map[string]interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		if _, ok := doc["number"]; !ok {
+			return errNoNumber
+		}
+		return nil
+	})
+	t.Cleanup(func() { dbtypes.SetJSONValidator(nil) })
+}
+
+func TestJSONValidatorSkipsNull(t *testing.T) {
+	setInvoiceValidator(t)
+
+	for _, value := range []interface{}{nil, "", []byte("null")} {
+		var j dbtypes.JSON
+		if err := j.Scan(value); err != nil {
+			t.Errorf("Scan(%v) returned error: %v", value, err)
+		}
+	}
+
+	var j dbtypes.JSON
+	if err := json.Unmarshal([]byte("null"), &j); err != nil || j != nil {
+		t.Errorf("json.Unmarshal(null) = %v, %v, want nil", j, err)
+	}
+}
+
+func TestJSONValidatorScanMap(t *testing.T) {
+	setInvoiceValidator(t)
+
+	var j dbtypes.JSON
+	var validationErr *dbtypes.JSONValidationError
+	if err := j.Scan(map[string]interface{}{"items": []interface{}{}}); !errors.As(err, &validationErr) {
+		t.Errorf("Scan(map) error = %v, want *JSONValidationError", err)
+	}
+	if err := j.Scan(dbtypes.JSON{"number": "INV-1"}); err != nil {
+		t.Errorf("Scan(JSON) returned error: %v", err)
+	}
+}
+
+func TestSetJSONValidator(t *testing.T) {
+	errNoTitle := errors.New("title is required")
+	dbtypes.SetJSONValidator(func(data []byte) error {
+		if !strings.Contains(string(data), `"title"`) {
+			return errNoTitle
+		}
+		return nil
+	})
+	defer dbtypes.SetJSONValidator(nil)
+
+	var j dbtypes.JSON
+	err := j.Scan(`{"name":"x"}`)
+	var validationErr *dbtypes.JSONValidationError
+	if !errors.As(err, &validationErr) || !errors.Is(err, errNoTitle) {
+		t.Fatalf("Scan() error = %v, want *JSONValidationError wrapping errNoTitle", err)
+	}
+	if validationErr.Path != "" || err.Error() != "invalid JSON document: title is required" {
+		t.Errorf("Scan() error = %q with path %q", err, validationErr.Path)
+	}
+
+	// Malformed JSON fails to decode before it is validated.
+	if err := j.Scan(`{"title":`); err == nil || errors.As(err, &validationErr) {
+		t.Errorf("Scan() of malformed JSON error = %v, want a syntax error", err)
+	}
+
+	if err := j.Scan(`{"title":"x"}`); err != nil {
+		t.Errorf("Scan() returned error: %v", err)
+	}
+
+	dbtypes.SetJSONValidator(nil)
+	if err := j.Scan(`{"name":"x"}`); err != nil {
+		t.Errorf("Scan() without a validator returned error: %v", err)
+	}
+}
+
+func TestJSONUnmarshalJSONUseNumber(t *testing.T) {
+	dbtypes.JSONUseNumber(true)
+	defer dbtypes.JSONUseNumber(false)
+
+	var j dbtypes.JSON
+	if err := json.Unmarshal([]byte(`{"id":9007199254740993}`), &j); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if got := j["id"]; got != json.Number("9007199254740993") {
+		t.Errorf("json.Unmarshal() id = %#v, want json.Number(9007199254740993)", got)
+	}
+}
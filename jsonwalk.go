@@ -0,0 +1,138 @@
+package dbtypes
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrStopWalk can be returned by the function passed to JSON.Walk to stop
+// the traversal. Walk then returns nil.
+var ErrStopWalk = errors.New("stop walk")
+
+// WalkOption configures Walk.
+type WalkOption func(*walkConfig)
+
+type walkConfig struct {
+	containers bool
+}
+
+// WalkContainers makes Walk also visit objects and arrays, before their children.
+func WalkContainers() WalkOption {
+	return func(c *walkConfig) {
+		c.containers = true
+	}
+}
+
+// Walk calls fn for every leaf value in j, depth first with object keys in
+// sorted order. Leaves are strings, numbers, booleans and nulls, as well as
+// empty objects and arrays. path uses the syntax of Get, e.g.
+// "items[2].price", so it can be passed back to Get, Set or Delete.
+//
+// If fn returns ErrStopWalk, Walk stops and returns nil; any other error
+// stops the walk and is returned. See WalkContainers to visit objects and
+// arrays too.
+func (j JSON) Walk(fn func(path string, value interface{}) error, opts ...WalkOption) error {
+	var cfg walkConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	err := walkObject(j, "", fn, &cfg)
+	if errors.Is(err, ErrStopWalk) {
+		return nil
+	}
+	return err
+}
+
+// walkObject walks the members of obj at path, see Walk.
+func walkObject(obj map[string]interface{}, path string, fn func(string, interface{}) error, cfg *walkConfig) error {
+	for _, key := range JSON(obj).Keys() {
+		if err := walkValue(obj[key], joinPathKey(path, key), fn, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkValue walks v at path, see Walk.
+func walkValue(v interface{}, path string, fn func(string, interface{}) error, cfg *walkConfig) error {
+	obj, isObj := asObject(v)
+	arr, isArr := v.([]interface{})
+	if (!isObj || len(obj) == 0) && (!isArr || len(arr) == 0) {
+		return fn(path, v)
+	}
+
+	if cfg.containers {
+		if err := fn(path, v); err != nil {
+			return err
+		}
+	}
+
+	if isObj {
+		return walkObject(obj, path, fn, cfg)
+	}
+	for i, elem := range arr {
+		if err := walkValue(elem, joinPathIndex(path, i), fn, cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Transform returns a copy of j in which every leaf value (see Walk) is
+// replaced with the result of fn. If fn returns false, the member or array
+// element is removed instead. Paths refer to j, so array indices are not
+// shifted by removals. j is not modified; nil objects return nil.
+func (j JSON) Transform(fn func(path string, value interface{}) (interface{}, bool)) JSON {
+	if j == nil {
+		return nil
+	}
+	return JSON(transformObject(j, "", fn))
+}
+
+// transformObject returns the transformed copy of obj at path, see Transform.
+func transformObject(obj map[string]interface{}, path string, fn func(string, interface{}) (interface{}, bool)) map[string]interface{} {
+	result := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		if v, ok := transformValue(value, joinPathKey(path, key), fn); ok {
+			result[key] = v
+		}
+	}
+	return result
+}
+
+// transformValue returns the transformed copy of v at path
+// and whether it is kept, see Transform.
+func transformValue(v interface{}, path string, fn func(string, interface{}) (interface{}, bool)) (interface{}, bool) {
+	if obj, ok := asObject(v); ok && len(obj) > 0 {
+		return transformObject(obj, path, fn), true
+	}
+
+	if arr, ok := v.([]interface{}); ok && len(arr) > 0 {
+		result := make([]interface{}, 0, len(arr))
+		for i, elem := range arr {
+			if v, ok := transformValue(elem, joinPathIndex(path, i), fn); ok {
+				result = append(result, v)
+			}
+		}
+		return result, true
+	}
+	return fn(path, deepCopy(v))
+}
+
+// pathKeyEscaper escapes the characters parsePath treats specially in keys.
+var pathKeyEscaper = strings.NewReplacer(`\`, `\\`, `.`, `\.`, `[`, `\[`)
+
+// joinPathKey returns the path of the member key of the object at path.
+func joinPathKey(path, key string) string {
+	if path == "" {
+		return pathKeyEscaper.Replace(key)
+	}
+	return path + "." + pathKeyEscaper.Replace(key)
+}
+
+// joinPathIndex returns the path of element i of the array at path.
+func joinPathIndex(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
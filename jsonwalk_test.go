@@ -0,0 +1,147 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+const walkFixture = `{
+	"title": "Invoice",
+	"customer": {"name": "Ann", "email": "ann@example.com"},
+	"items": [{"sku": "a", "qty": 1}, {"sku": "b", "tags": ["x", "y"]}],
+	"empty": {},
+	"none": [],
+	"paid": null,
+	"a.b": {"c[0]": true}
+}`
+
+func TestJSONWalk(t *testing.T) {
+	j := mustJSON(t, walkFixture)
+
+	var paths []string
+	err := j.Walk(func(path string, value interface{}) error {
+		paths = append(paths, path)
+
+		// Paths can be passed back to Get.
+		if got, ok := j.Get(path); !ok || !reflect.DeepEqual(got, value) {
+			t.Errorf("Get(%q) = %v, %v, want %v", path, got, ok, value)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() returned error: %v", err)
+	}
+
+	want := []string{
+		`a\.b.c\[0]`,
+		"customer.email",
+		"customer.name",
+		"empty",
+		"items[0].qty",
+		"items[0].sku",
+		"items[1].sku",
+		"items[1].tags[0]",
+		"items[1].tags[1]",
+		"none",
+		"paid",
+		"title",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Walk() visited %q, want %q", paths, want)
+	}
+}
+
+func TestJSONWalkContainers(t *testing.T) {
+	j := mustJSON(t, `{"a":{"b":[1,{"c":2}]},"d":3}`)
+
+	var paths []string
+	err := j.Walk(func(path string, value interface{}) error {
+		paths = append(paths, path)
+		return nil
+	}, dbtypes.WalkContainers())
+	if err != nil {
+		t.Fatalf("Walk() returned error: %v", err)
+	}
+
+	want := []string{"a", "a.b", "a.b[0]", "a.b[1]", "a.b[1].c", "d"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Walk() visited %q, want %q", paths, want)
+	}
+}
+
+func TestJSONWalkStop(t *testing.T) {
+	j := mustJSON(t, walkFixture)
+
+	var paths []string
+	err := j.Walk(func(path string, value interface{}) error {
+		paths = append(paths, path)
+		if strings.HasPrefix(path, "items") {
+			return dbtypes.ErrStopWalk
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Walk() with ErrStopWalk returned error: %v", err)
+	}
+	if want := []string{`a\.b.c\[0]`, "customer.email", "customer.name", "empty", "items[0].qty"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("Walk() visited %q, want %q", paths, want)
+	}
+
+	errBoom := errors.New("boom")
+	count := 0
+	err = j.Walk(func(path string, value interface{}) error {
+		count++
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) || count != 1 {
+		t.Errorf("Walk() = %v after %d calls, want errBoom after 1", err, count)
+	}
+
+	var nilJSON dbtypes.JSON
+	if err := nilJSON.Walk(func(string, interface{}) error { return errBoom }); err != nil {
+		t.Errorf("nil Walk() = %v, want nil", err)
+	}
+}
+
+func TestJSONTransform(t *testing.T) {
+	j := mustJSON(t, walkFixture)
+	original := jsonString(t, j)
+
+	got := j.Transform(func(path string, value interface{}) (interface{}, bool) {
+		switch {
+		case path == "customer.email" || value == nil:
+			return nil, false
+		case path == "items[1].tags[0]":
+			return nil, false
+		case path == "empty":
+			return "was empty", true
+		}
+		if s, ok := value.(string); ok {
+			return strings.ToUpper(s), true
+		}
+		return value, true
+	})
+
+	want := `{"a.b":{"c[0]":true},"customer":{"name":"ANN"},"empty":"was empty","items":[{"qty":1,"sku":"A"},{"sku":"B","tags":["Y"]}],"none":[],"title":"INVOICE"}`
+	if s := jsonString(t, got); s != want {
+		t.Errorf("Transform() = %s, want %s", s, want)
+	}
+	if s := jsonString(t, j); s != original {
+		t.Errorf("Transform() modified the original to %s", s)
+	}
+
+	// The copy shares nothing with the original.
+	got["none"] = append(got["none"].([]interface{}), 1)
+	if s := jsonString(t, j); s != original {
+		t.Errorf("modifying the copy changed the original to %s", s)
+	}
+
+	var nilJSON dbtypes.JSON
+	if got := nilJSON.Transform(func(string, interface{}) (interface{}, bool) { return 1, true }); got != nil {
+		t.Errorf("nil Transform() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,119 @@
+package dbtypes
+
+import (
+	"log/slog"
+	"path"
+	"strings"
+	"time"
+)
+
+// LogValue implements slog.LogValuer, logging the date as yyyy-mm-dd.
+// Zero dates are logged as an empty string, matching String.
+func (date Date) LogValue() slog.Value {
+	return slog.StringValue(date.String())
+}
+
+// LogValue implements slog.LogValuer, logging the date as yyyy-mm-dd
+// or an empty string if NULL.
+func (nd NullDate) LogValue() slog.Value {
+	return slog.StringValue(nd.String())
+}
+
+// GoString implements fmt.GoStringer, exposing the full underlying time
+// for debugging with the %#v verb.
+//
+// Date cannot implement fmt.Formatter because Format is already used for
+// layout-based formatting; %v, %s and %q use String instead.
+func (date Date) GoString() string {
+	return "dbtypes.Date(" + time.Time(date).GoString() + ")"
+}
+
+// Redacted replaces the values of keys removed by JSON.Redact.
+const Redacted = "[REDACTED]"
+
+// logRedactKeys are the keys redacted when logging JSON values.
+var logRedactKeys = []string{"password", "*secret", "*token", "authorization", "*api_key", "cookie"}
+
+// SetLogRedactKeys sets the keys redacted by JSON.LogValue (see Redact),
+// replacing the default list: "password", "*secret", "*token",
+// "authorization", "*api_key" and "cookie". Call it without keys to log
+// documents unredacted.
+// This should be called once at program startup.
+func SetLogRedactKeys(keys ...string) {
+	logRedactKeys = keys
+}
+
+// Redact returns a deep copy of j with the values of matching keys replaced
+// by Redacted, at any depth including objects within arrays. Keys are
+// matched case-insensitively and may be glob patterns as understood by
+// path.Match, e.g. "*_token". j is not modified; nil objects return nil.
+func (j JSON) Redact(keys ...string) JSON {
+	if j == nil {
+		return nil
+	}
+
+	patterns := make([]string, len(keys))
+	for i, key := range keys {
+		patterns[i] = strings.ToLower(key)
+	}
+	return JSON(redactObject(j, patterns))
+}
+
+// redactObject returns a copy of obj with the keys matching patterns redacted.
+func redactObject(obj map[string]interface{}, patterns []string) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		if matchesAny(strings.ToLower(key), patterns) {
+			redacted[key] = Redacted
+		} else {
+			redacted[key] = redactValue(value, patterns)
+		}
+	}
+	return redacted
+}
+
+// redactValue returns a copy of v with the object keys matching patterns redacted.
+func redactValue(v interface{}, patterns []string) interface{} {
+	if obj, ok := asObject(v); ok {
+		return redactObject(obj, patterns)
+	}
+
+	if arr, ok := v.([]interface{}); ok && arr != nil {
+		redacted := make([]interface{}, len(arr))
+		for i, elem := range arr {
+			redacted[i] = redactValue(elem, patterns)
+		}
+		return redacted
+	}
+	return deepCopy(v)
+}
+
+// matchesAny reports whether key matches any of the glob patterns.
+// Malformed patterns only match themselves.
+func matchesAny(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, key); ok || (err != nil && pattern == key) {
+			return true
+		}
+	}
+	return false
+}
+
+// loggedJSON is a redacted JSON document as logged by JSON.LogValue. Unlike
+// JSON it does not implement slog.LogValuer, so slog does not resolve it again.
+type loggedJSON map[string]interface{}
+
+// String returns the document as compact JSON, see JSON.String.
+func (l loggedJSON) String() string {
+	return JSON(l).String()
+}
+
+// LogValue implements slog.LogValuer, logging the document with the keys set
+// with SetLogRedactKeys redacted. JSON handlers log it as an object and text
+// handlers as compact JSON.
+func (j JSON) LogValue() slog.Value {
+	if j == nil {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(loggedJSON(j.Redact(logRedactKeys...)))
+}
@@ -0,0 +1,147 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateLogValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "date", value: dbtypes.NewDateUTC(2015, time.October, 21), want: "2015-10-21"},
+		{name: "zero date", value: dbtypes.Date{}, want: ""},
+		{name: "pointer", value: func() *dbtypes.Date { d := dbtypes.NewDateUTC(2015, time.October, 21); return &d }(), want: "2015-10-21"},
+		{name: "null date", value: dbtypes.NullDateFrom(dbtypes.NewDateUTC(2015, time.October, 21)), want: "2015-10-21"},
+		{name: "invalid null date", value: dbtypes.NullDate{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, nil))
+			logger.Info("msg", "date", tt.value)
+
+			var record map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t.Fatalf("json.Unmarshal(%s) returned error: %v", buf.Bytes(), err)
+			}
+			if got := record["date"]; got != tt.want {
+				t.Errorf("logged date = %#v, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateFmtVerbs(t *testing.T) {
+	date := dbtypes.NewDateUTC(2015, time.October, 21)
+
+	tests := []struct {
+		format string
+		date   dbtypes.Date
+		want   string
+	}{
+		{format: "%v", date: date, want: "2015-10-21"},
+		{format: "%s", date: date, want: "2015-10-21"},
+		{format: "%q", date: date, want: `"2015-10-21"`},
+		{format: "%+v", date: date, want: "2015-10-21"},
+		{format: "%#v", date: date, want: "dbtypes.Date(time.Date(2015, time.October, 21, 0, 0, 0, 0, time.UTC))"},
+		{format: "%v", date: dbtypes.Date{}, want: ""},
+		{format: "%q", date: dbtypes.Date{}, want: `""`},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.date); got != tt.want {
+			t.Errorf("Sprintf(%q, %s) = %q, want %q", tt.format, tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestJSONRedact(t *testing.T) {
+	doc := `{
+		"user": {"name": "Ann", "Password": "hunter2", "profile": {"API_KEY": "k1", "bio": "hi"}},
+		"sessions": [{"access_token": "t1", "id": 1}, {"refresh_token": "t2", "id": 2}, "plain", [{"token": "t3"}]],
+		"token": {"nested": "whole object"},
+		"tokenizer": "kept",
+		"[bad": "literal match"
+	}`
+	j := mustJSON(t, doc)
+	original := jsonString(t, j)
+
+	got := j.Redact("password", "*_token", "api_key", "TOKEN", "[bad")
+	want := `{"[bad":"[REDACTED]","sessions":[{"access_token":"[REDACTED]","id":1},{"id":2,"refresh_token":"[REDACTED]"},"plain",[{"token":"[REDACTED]"}]],"token":"[REDACTED]","tokenizer":"kept","user":{"Password":"[REDACTED]","name":"Ann","profile":{"API_KEY":"[REDACTED]","bio":"hi"}}}`
+	if s := jsonString(t, got); s != want {
+		t.Errorf("Redact() = %s, want %s", s, want)
+	}
+
+	if s := jsonString(t, j); s != original {
+		t.Errorf("Redact() modified the original to %s", s)
+	}
+
+	// The copy is deep, so modifying it leaves the original untouched.
+	got["sessions"].([]interface{})[0].(map[string]interface{})["id"] = 99
+	if s := jsonString(t, j); s != original {
+		t.Errorf("modifying the redacted copy changed the original to %s", s)
+	}
+
+	if got := j.Redact(); jsonString(t, got) != original {
+		t.Errorf("Redact() without keys = %s, want %s", jsonString(t, got), original)
+	}
+
+	var nilJSON dbtypes.JSON
+	if got := nilJSON.Redact("password"); got != nil {
+		t.Errorf("nil Redact() = %v, want nil", got)
+	}
+}
+
+func TestJSONLogValue(t *testing.T) {
+	j := dbtypes.JSON{
+		"user":    map[string]interface{}{"name": "Ann", "password": "hunter2"},
+		"headers": []interface{}{map[string]interface{}{"Authorization": "Bearer x", "Accept": "*/*"}},
+		"tokens":  map[string]interface{}{"github_token": "ghp", "client_secret": "s"},
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("msg", "doc", j)
+
+	var record struct {
+		Doc map[string]interface{} `json:"doc"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned error: %v", buf.Bytes(), err)
+	}
+	want := `{"headers":[{"Accept":"*/*","Authorization":"[REDACTED]"}],"tokens":{"client_secret":"[REDACTED]","github_token":"[REDACTED]"},"user":{"name":"Ann","password":"[REDACTED]"}}`
+	if got := jsonString(t, record.Doc); got != want {
+		t.Errorf("logged doc = %s, want %s", got, want)
+	}
+
+	buf.Reset()
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "doc", dbtypes.JSON{"password": "x"})
+	if got := buf.String(); !strings.Contains(got, `doc="{\"password\":\"[REDACTED]\"}"`) {
+		t.Errorf("text log = %s, want compact redacted JSON", got)
+	}
+
+	dbtypes.SetLogRedactKeys("name")
+	defer dbtypes.SetLogRedactKeys("password", "*secret", "*token", "authorization", "*api_key", "cookie")
+
+	buf.Reset()
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "doc", dbtypes.JSON{"password": "x", "Name": "Ann"})
+	if got := buf.String(); !strings.Contains(got, `doc="{\"Name\":\"[REDACTED]\",\"password\":\"x\"}"`) {
+		t.Errorf("text log with custom keys = %s", got)
+	}
+
+	buf.Reset()
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "doc", dbtypes.JSON(nil))
+	if got := buf.String(); !strings.Contains(got, "doc=<nil>") {
+		t.Errorf("text log of nil JSON = %s", got)
+	}
+}
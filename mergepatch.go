@@ -0,0 +1,111 @@
+package dbtypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrNotObject is returned when a JSON document is valid
+	// but is not an object, e.g. an array or a string.
+	ErrNotObject = errors.New("JSON value is not an object")
+
+	// ErrNullInMergePatch is returned by CreateMergePatch when the modified
+	// document sets a member to null, which a merge patch cannot express
+	// since null removes the member.
+	ErrNullInMergePatch = errors.New("merge patch cannot set a member to null")
+)
+
+// ApplyMergePatch applies an RFC 7386 JSON merge patch to j and returns the
+// result without modifying j: null removes a member, objects are patched
+// recursively and any other value replaces the member.
+//
+// A null patch returns nil. Patches that are not objects would replace the
+// whole document with a non-object and result in an error wrapping ErrNotObject.
+// For a patch that is already decoded, j.Merge(patch, MergeNullDeletes())
+// is equivalent.
+func (j JSON) ApplyMergePatch(patch []byte) (JSON, error) {
+	patch = bytes.TrimSpace(patch)
+	if bytes.Equal(patch, []byte("null")) {
+		return nil, nil
+	}
+	if len(patch) > 0 && patch[0] != '{' && json.Valid(patch) {
+		return nil, fmt.Errorf("merge patch: %w", ErrNotObject)
+	}
+
+	obj, err := decodeJSONObject(patch)
+	if err != nil {
+		return nil, fmt.Errorf("merge patch: %w", err)
+	}
+	return j.Merge(JSON(obj), MergeNullDeletes()), nil
+}
+
+// CreateMergePatch returns the smallest RFC 7386 merge patch that turns
+// original into modified: removed members are null, changed objects are
+// diffed recursively and any other changed value is included whole.
+// Applying the result to original with ApplyMergePatch yields modified.
+//
+// It returns an error wrapping ErrNullInMergePatch if modified adds or
+// changes a member (at any depth within a changed object) to null.
+func CreateMergePatch(original, modified JSON) (JSON, error) {
+	patch, err := diffObjects(original, modified, "")
+	if err != nil {
+		return nil, err
+	}
+	return JSON(patch), nil
+}
+
+// diffObjects returns the merge patch from original to modified.
+// prefix is the path of the objects, used in errors.
+func diffObjects(original, modified map[string]interface{}, prefix string) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	for key := range original {
+		if _, ok := modified[key]; !ok {
+			patch[key] = nil
+		}
+	}
+
+	for key, value := range JSON(modified).SortedEntries() {
+		path := prefix + key
+		old, exists := original[key]
+		if exists && reflect.DeepEqual(old, value) {
+			continue
+		}
+
+		oldObj, oldIsObj := asObject(old)
+		newObj, newIsObj := asObject(value)
+		switch {
+		case oldIsObj && newIsObj:
+			sub, err := diffObjects(oldObj, newObj, path+".")
+			if err != nil {
+				return nil, err
+			}
+			if len(sub) > 0 {
+				patch[key] = sub
+			}
+		case value == nil || containsNullMember(value):
+			return nil, fmt.Errorf("%q: %w", path, ErrNullInMergePatch)
+		default:
+			patch[key] = deepCopy(value)
+		}
+	}
+	return patch, nil
+}
+
+// containsNullMember reports whether v is an object with a null member
+// at any depth. Nulls within arrays are kept by merge patches.
+func containsNullMember(v interface{}) bool {
+	obj, ok := asObject(v)
+	if !ok {
+		return false
+	}
+	for _, value := range obj {
+		if value == nil || containsNullMember(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// mergePatchExamples are the examples of RFC 7386 Appendix A whose target
+// and result are objects. The examples with array targets do not apply to JSON.
+var mergePatchExamples = []struct {
+	target, patch, want string
+}{
+	{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+	{`{"a":"b"}`, `{"a":null}`, `{}`},
+	{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+	{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+	{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+	{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+	{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+	{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+	{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+}
+
+func TestJSONApplyMergePatch(t *testing.T) {
+	for _, tt := range mergePatchExamples {
+		target := mustJSON(t, tt.target)
+		got, err := target.ApplyMergePatch([]byte(tt.patch))
+		if err != nil {
+			t.Errorf("%s.ApplyMergePatch(%s) returned error: %v", tt.target, tt.patch, err)
+			continue
+		}
+		if s := jsonString(t, got); s != tt.want {
+			t.Errorf("%s.ApplyMergePatch(%s) = %s, want %s", tt.target, tt.patch, s, tt.want)
+		}
+		if s := jsonString(t, target); s != jsonString(t, mustJSON(t, tt.target)) {
+			t.Errorf("ApplyMergePatch(%s) modified the target to %s", tt.patch, s)
+		}
+	}
+}
+
+func TestJSONApplyMergePatchRFCExample(t *testing.T) {
+	target := mustJSON(t, `{
+		"title": "Goodbye!",
+		"author": {"givenName": "John", "familyName": "Doe"},
+		"tags": ["example", "sample"],
+		"content": "This will be unchanged"
+	}`)
+	patch := `{
+		"title": "Hello!",
+		"phoneNumber": "+01-123-456-7890",
+		"author": {"familyName": null},
+		"tags": ["example"]
+	}`
+	want := `{"author":{"givenName":"John"},"content":"This will be unchanged","phoneNumber":"+01-123-456-7890","tags":["example"],"title":"Hello!"}`
+
+	got, err := target.ApplyMergePatch([]byte(patch))
+	if err != nil {
+		t.Fatalf("ApplyMergePatch() returned error: %v", err)
+	}
+	if s := jsonString(t, got); s != want {
+		t.Errorf("ApplyMergePatch() = %s, want %s", s, want)
+	}
+}
+
+func TestJSONApplyMergePatchNonObject(t *testing.T) {
+	target := mustJSON(t, `{"a":"foo"}`)
+
+	got, err := target.ApplyMergePatch([]byte(" null "))
+	if err != nil || got != nil {
+		t.Errorf("ApplyMergePatch(null) = %v, %v, want nil, nil", got, err)
+	}
+
+	for _, patch := range []string{`["c"]`, `"bar"`, `42`, `true`} {
+		if _, err := target.ApplyMergePatch([]byte(patch)); !errors.Is(err, dbtypes.ErrNotObject) {
+			t.Errorf("ApplyMergePatch(%s) error = %v, want ErrNotObject", patch, err)
+		}
+	}
+
+	for _, patch := range []string{``, `{`, `{"a":1} {}`} {
+		if _, err := target.ApplyMergePatch([]byte(patch)); err == nil || errors.Is(err, dbtypes.ErrNotObject) {
+			t.Errorf("ApplyMergePatch(%q) error = %v, want a syntax error", patch, err)
+		}
+	}
+}
+
+func TestCreateMergePatch(t *testing.T) {
+	for _, tt := range mergePatchExamples {
+		original, modified := mustJSON(t, tt.target), mustJSON(t, tt.want)
+
+		patch, err := dbtypes.CreateMergePatch(original, modified)
+		if err != nil {
+			t.Errorf("CreateMergePatch(%s, %s) returned error: %v", tt.target, tt.want, err)
+			continue
+		}
+
+		got, err := original.ApplyMergePatch([]byte(jsonString(t, patch)))
+		if err != nil {
+			t.Errorf("ApplyMergePatch(%s) returned error: %v", jsonString(t, patch), err)
+			continue
+		}
+		if s := jsonString(t, got); s != tt.want {
+			t.Errorf("CreateMergePatch(%s, %s) = %s, which applies to %s", tt.target, tt.want, jsonString(t, patch), s)
+		}
+	}
+}
+
+func TestCreateMergePatchMinimal(t *testing.T) {
+	tests := []struct {
+		original, modified, want string
+	}{
+		{`{"a":1,"b":{"c":2,"d":3}}`, `{"a":1,"b":{"c":2,"d":3}}`, `{}`},
+		{`{"a":1,"b":{"c":2,"d":3}}`, `{"a":1,"b":{"c":2,"d":4}}`, `{"b":{"d":4}}`},
+		{`{"a":1,"b":{"c":2,"d":3}}`, `{"b":{"c":2}}`, `{"a":null,"b":{"d":null}}`},
+		{`{"a":[1,2]}`, `{"a":[1,2,3]}`, `{"a":[1,2,3]}`},
+		{`{"a":"x"}`, `{"a":{"b":[null]}}`, `{"a":{"b":[null]}}`},
+	}
+
+	for _, tt := range tests {
+		got, err := dbtypes.CreateMergePatch(mustJSON(t, tt.original), mustJSON(t, tt.modified))
+		if err != nil {
+			t.Errorf("CreateMergePatch(%s, %s) returned error: %v", tt.original, tt.modified, err)
+			continue
+		}
+		if s := jsonString(t, got); s != tt.want {
+			t.Errorf("CreateMergePatch(%s, %s) = %s, want %s", tt.original, tt.modified, s, tt.want)
+		}
+	}
+}
+
+func TestCreateMergePatchNull(t *testing.T) {
+	tests := []struct {
+		original, modified string
+	}{
+		{`{"a":1}`, `{"a":null}`},
+		{`{}`, `{"a":null}`},
+		{`{"a":"x"}`, `{"a":{"b":null}}`},
+		{`{"a":{"b":{}}}`, `{"a":{"b":{"c":{"d":null}}}}`},
+	}
+
+	for _, tt := range tests {
+		_, err := dbtypes.CreateMergePatch(mustJSON(t, tt.original), mustJSON(t, tt.modified))
+		if !errors.Is(err, dbtypes.ErrNullInMergePatch) {
+			t.Errorf("CreateMergePatch(%s, %s) error = %v, want ErrNullInMergePatch", tt.original, tt.modified, err)
+		}
+	}
+}
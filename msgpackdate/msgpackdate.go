@@ -0,0 +1,75 @@
+// Package msgpackdate registers MessagePack encoders and decoders for
+// dbtypes.Date and dbtypes.JSON with github.com/vmihailenco/msgpack/v5.
+// It lives in its own package so that the core dbtypes package
+// does not depend on msgpack.
+package msgpackdate
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Register registers the msgpack encoders and decoders for dbtypes.Date
+// and dbtypes.JSON.
+//
+// Like MarshalJSON, dates are encoded as yyyy-mm-dd strings and zero dates
+// as nil. Decoding accepts nil, strings in the formats accepted by
+// Date.UnmarshalText, and the binary encoding of Date.MarshalBinary.
+// JSON objects are encoded as msgpack maps, and nil objects as nil.
+//
+// This should be called once at program startup.
+func Register() {
+	msgpack.Register(dbtypes.Date{}, encodeDate, decodeDate)
+	msgpack.Register(dbtypes.JSON{}, encodeJSON, decodeJSON)
+}
+
+func encodeDate(enc *msgpack.Encoder, v reflect.Value) error {
+	date := v.Interface().(dbtypes.Date)
+	if date.IsZero() {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(date.String())
+}
+
+func decodeDate(dec *msgpack.Decoder, v reflect.Value) error {
+	x, err := dec.DecodeInterface()
+	if err != nil {
+		return err
+	}
+
+	var date dbtypes.Date
+	switch x := x.(type) {
+	case nil:
+	case string:
+		err = date.UnmarshalText([]byte(x))
+	case []byte:
+		err = date.UnmarshalBinary(x)
+	default:
+		err = fmt.Errorf("msgpackdate: cannot decode msgpack %T into Date", x)
+	}
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(date))
+	return nil
+}
+
+func encodeJSON(enc *msgpack.Encoder, v reflect.Value) error {
+	j := v.Interface().(dbtypes.JSON)
+	if j == nil {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeMap(map[string]interface{}(j))
+}
+
+func decodeJSON(dec *msgpack.Decoder, v reflect.Value) error {
+	m, err := dec.DecodeMap()
+	if err != nil {
+		return err
+	}
+	v.Set(reflect.ValueOf(dbtypes.JSON(m)))
+	return nil
+}
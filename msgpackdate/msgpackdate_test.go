@@ -0,0 +1,116 @@
+package msgpackdate_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/abiiranathan/dbtypes/msgpackdate"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestMain(m *testing.M) {
+	msgpackdate.Register()
+	os.Exit(m.Run())
+}
+
+type msgpackRecord struct {
+	Born    dbtypes.Date
+	Died    *dbtypes.Date
+	Details dbtypes.JSON
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	died := dbtypes.NewDateUTC(2015, time.October, 21)
+
+	tests := []struct {
+		name   string
+		record msgpackRecord
+	}{
+		{
+			name: "full",
+			record: msgpackRecord{
+				Born:    dbtypes.NewDateUTC(1955, time.November, 5),
+				Died:    &died,
+				Details: dbtypes.JSON{"name": "doc", "tags": []interface{}{"a", "b"}, "nested": map[string]interface{}{"ok": true}},
+			},
+		},
+		{name: "empty", record: msgpackRecord{Details: dbtypes.JSON{}}},
+		{name: "nil", record: msgpackRecord{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := msgpack.Marshal(tt.record)
+			if err != nil {
+				t.Fatalf("msgpack.Marshal() returned error: %v", err)
+			}
+
+			var got msgpackRecord
+			if err := msgpack.Unmarshal(data, &got); err != nil {
+				t.Fatalf("msgpack.Unmarshal() returned error: %v", err)
+			}
+			if got.Born.String() != tt.record.Born.String() || got.Born.IsZero() != tt.record.Born.IsZero() {
+				t.Errorf("Born = %s, want %s", got.Born, tt.record.Born)
+			}
+			if (got.Died == nil) != (tt.record.Died == nil) || (got.Died != nil && !got.Died.Equal(*tt.record.Died)) {
+				t.Errorf("Died = %v, want %v", got.Died, tt.record.Died)
+			}
+			if !reflect.DeepEqual(got.Details, tt.record.Details) {
+				t.Errorf("Details = %#v, want %#v", got.Details, tt.record.Details)
+			}
+		})
+	}
+}
+
+func TestDateMsgpackEncoding(t *testing.T) {
+	tests := []struct {
+		date dbtypes.Date
+		want interface{}
+	}{
+		{date: dbtypes.NewDateUTC(2015, time.October, 21), want: "2015-10-21"},
+		{date: dbtypes.Date{}, want: nil},
+	}
+
+	for _, tt := range tests {
+		data, err := msgpack.Marshal(tt.date)
+		if err != nil {
+			t.Fatalf("msgpack.Marshal(%s) returned error: %v", tt.date, err)
+		}
+
+		var got interface{}
+		if err := msgpack.Unmarshal(data, &got); err != nil {
+			t.Fatalf("msgpack.Unmarshal() returned error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("msgpack.Marshal(%s) decodes to %#v, want %#v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestDateMsgpackDecodeBinary(t *testing.T) {
+	want := dbtypes.NewDateUTC(2015, time.October, 21)
+	bin, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := msgpack.Marshal(bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got dbtypes.Date
+	if err := msgpack.Unmarshal(data, &got); err != nil || !got.Equal(want) {
+		t.Errorf("msgpack.Unmarshal(binary) = %s, %v, want %s", got, err, want)
+	}
+
+	data, err = msgpack.Marshal(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgpack.Unmarshal(data, &got); err == nil {
+		t.Errorf("msgpack.Unmarshal(42) error = nil, want error")
+	}
+}
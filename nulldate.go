@@ -0,0 +1,158 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NullDate represents a Date that may be NULL.
+// It mirrors sql.NullTime but marshals to yyyy-mm-dd JSON,
+// allowing callers to distinguish a NULL column from the zero date.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NullDateFrom returns a valid NullDate wrapping date.
+func NullDateFrom(date Date) NullDate {
+	return NullDate{Date: date, Valid: true}
+}
+
+// DateOrZero returns the wrapped date or the zero Date if nd is NULL.
+func (nd NullDate) DateOrZero() Date {
+	if !nd.Valid {
+		return Date{}
+	}
+	return nd.Date
+}
+
+// Scan implements the sql.Scanner interface.
+func (nd *NullDate) Scan(value interface{}) error {
+	if value == nil {
+		nd.Date, nd.Valid = Date{}, false
+		return nil
+	}
+
+	if err := nd.Date.Scan(value); err != nil {
+		nd.Valid = false
+		return err
+	}
+	nd.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (nd NullDate) Value() (driver.Value, error) {
+	if !nd.Valid {
+		return nil, nil
+	}
+
+	y, m, d := time.Time(nd.Date).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Time(nd.Date).Location()), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (nd NullDate) GormDataType() string {
+	return "date"
+}
+
+// MarshalJSON marshals a valid NullDate as a yyyy-mm-dd string
+// (including the zero date) and a NULL one as null.
+func (nd NullDate) MarshalJSON() ([]byte, error) {
+	if !nd.Valid {
+		return []byte("null"), nil
+	}
+
+	b := make([]byte, 0, len(DateLayout)+2)
+	b = append(b, '"')
+	b = appendDate(b, nd.Date)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON sets the NullDate to NULL for null or an empty string,
+// otherwise the date is parsed like Date.UnmarshalJSON.
+func (nd *NullDate) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte(`""`)) {
+		nd.Date, nd.Valid = Date{}, false
+		return nil
+	}
+
+	var date Date
+	if err := date.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	nd.Date, nd.Valid = date, true
+	return nil
+}
+
+// FormScan implements the FormScanner interface.
+// Strings, byte slices and the first element of string slices are parsed
+// alike; empty or blank values (and empty slices) set the NullDate to NULL.
+func (nd *NullDate) FormScan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		value = string(v)
+	case []string:
+		value = ""
+		if len(v) > 0 {
+			value = v[0]
+		}
+	}
+
+	if s, ok := value.(string); ok && strings.TrimSpace(s) == "" {
+		nd.Date, nd.Valid = Date{}, false
+		return nil
+	}
+
+	var date Date
+	if err := date.FormScan(value); err != nil {
+		return err
+	}
+	nd.Date, nd.Valid = date, true
+	return nil
+}
+
+// GobEncode encodes the NullDate as a validity byte followed by the gob-encoded date.
+func (nd NullDate) GobEncode() ([]byte, error) {
+	if !nd.Valid {
+		return []byte{0}, nil
+	}
+
+	b, err := nd.Date.GobEncode()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{1}, b...), nil
+}
+
+// GobDecode decodes data produced by GobEncode.
+func (nd *NullDate) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("error decoding NullDate: no data")
+	}
+
+	if data[0] == 0 {
+		nd.Date, nd.Valid = Date{}, false
+		return nil
+	}
+
+	var date Date
+	if err := date.GobDecode(data[1:]); err != nil {
+		return err
+	}
+	nd.Date, nd.Valid = date, true
+	return nil
+}
+
+// String returns the date as yyyy-mm-dd or an empty string if NULL.
+func (nd NullDate) String() string {
+	if !nd.Valid {
+		return ""
+	}
+	return nd.Date.String()
+}
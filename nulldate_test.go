@@ -0,0 +1,174 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestNullDateScan(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		wantValid bool
+		want      dbtypes.Date
+	}{
+		{name: "NULL", value: nil, wantValid: false},
+		{name: "zero time", value: time.Time{}, wantValid: true, want: dbtypes.Date{}},
+		{name: "time", value: time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC), wantValid: true, want: dbtypes.Date(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC))},
+		{name: "string", value: "2015-10-21", wantValid: true, want: dbtypes.Date(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nd dbtypes.NullDate
+			if err := nd.Scan(tt.value); err != nil {
+				t.Fatalf("Scan(%v) returned error: %v", tt.value, err)
+			}
+			if nd.Valid != tt.wantValid {
+				t.Fatalf("Scan(%v) Valid = %v, want %v", tt.value, nd.Valid, tt.wantValid)
+			}
+			if nd.Valid && !nd.Date.Equal(tt.want) {
+				t.Errorf("Scan(%v) Date = %s, want %s", tt.value, nd.Date, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDateValue(t *testing.T) {
+	value, err := dbtypes.NullDate{}.Value()
+	if err != nil || value != nil {
+		t.Errorf("Value() of NULL date = %v, %v; want nil, nil", value, err)
+	}
+
+	value, err = dbtypes.NullDateFrom(dbtypes.Date{}).Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if tm, ok := value.(time.Time); !ok || !tm.IsZero() {
+		t.Errorf("Value() of zero date = %v, want zero time.Time", value)
+	}
+}
+
+func TestNullDateJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		nd   dbtypes.NullDate
+		want string
+	}{
+		{name: "NULL", nd: dbtypes.NullDate{}, want: "null"},
+		{name: "zero date", nd: dbtypes.NullDateFrom(dbtypes.Date{}), want: `"0001-01-01"`},
+		{name: "date", nd: dbtypes.NullDateFrom(dbtypes.NewDate(2015, time.October, 21)), want: `"2015-10-21"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.nd)
+			if err != nil {
+				t.Fatalf("Failed to marshal NullDate: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", data, tt.want)
+			}
+
+			var got dbtypes.NullDate
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Failed to unmarshal %s: %v", data, err)
+			}
+			if got.Valid != tt.nd.Valid || !got.Date.Equal(tt.nd.Date) {
+				t.Errorf("Round-trip = %+v, want %+v", got, tt.nd)
+			}
+		})
+	}
+
+	var nd dbtypes.NullDate
+	if err := json.Unmarshal([]byte(`""`), &nd); err != nil || nd.Valid {
+		t.Errorf("Unmarshal empty string = %+v, %v; want NULL", nd, err)
+	}
+	if err := json.Unmarshal([]byte(`"21/10/2015"`), &nd); err == nil {
+		t.Errorf("Expected error for invalid date")
+	}
+}
+
+func TestNullDateFormScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "string", value: "2020-02-29", want: "2020-02-29"},
+		{name: "bytes", value: []byte("2020-02-29"), want: "2020-02-29"},
+		{name: "string slice", value: []string{"2020-02-29", "2021-01-01"}, want: "2020-02-29"},
+		{name: "empty string", value: ""},
+		{name: "blank string", value: "  "},
+		{name: "empty bytes", value: []byte("")},
+		{name: "blank bytes", value: []byte(" ")},
+		{name: "slice of empty string", value: []string{""}},
+		{name: "slice of blank string", value: []string{" "}},
+		{name: "empty slice", value: []string{}},
+		{name: "invalid string", value: "not a date", wantErr: true},
+		{name: "invalid bytes", value: []byte("not a date"), wantErr: true},
+		{name: "int", value: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nd := dbtypes.NullDateFrom(dbtypes.NewDate(2015, time.October, 21))
+			err := nd.FormScan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FormScan(%#v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if tt.want == "" {
+				if nd.Valid || !nd.Date.IsZero() {
+					t.Errorf("FormScan(%#v) = %+v, want NULL", tt.value, nd)
+				}
+				return
+			}
+			if !nd.Valid || nd.Date.String() != tt.want {
+				t.Errorf("FormScan(%#v) = %+v, want %s", tt.value, nd, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDateGob(t *testing.T) {
+	tests := []dbtypes.NullDate{
+		{},
+		dbtypes.NullDateFrom(dbtypes.Date{}),
+		dbtypes.NullDateFrom(dbtypes.NewDate(2015, time.October, 21)),
+	}
+
+	for _, nd := range tests {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(nd); err != nil {
+			t.Fatalf("Failed to encode %+v: %v", nd, err)
+		}
+
+		var got dbtypes.NullDate
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("Failed to decode %+v: %v", nd, err)
+		}
+		if got.Valid != nd.Valid || !got.Date.Equal(nd.Date) {
+			t.Errorf("Gob round-trip = %+v, want %+v", got, nd)
+		}
+	}
+}
+
+func TestNullDateDateOrZero(t *testing.T) {
+	date := dbtypes.NewDate(2015, time.October, 21)
+	if got := dbtypes.NullDateFrom(date).DateOrZero(); !got.Equal(date) {
+		t.Errorf("DateOrZero() = %s, want %s", got, date)
+	}
+	if got := (dbtypes.NullDate{Date: date}).DateOrZero(); !got.IsZero() {
+		t.Errorf("DateOrZero() of NULL = %s, want zero", got)
+	}
+}
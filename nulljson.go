@@ -0,0 +1,172 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NullJSON represents a JSON object that may be NULL.
+// Unlike JSON, whose nil and empty maps are both usable, it distinguishes
+// a NULL column (Valid false) from an empty object when scanning and
+// writing back.
+type NullJSON struct {
+	JSON  JSON
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// NullJSONFrom returns a valid NullJSON wrapping j.
+// A nil j is written as an empty object.
+func NullJSONFrom(j JSON) NullJSON {
+	return NullJSON{JSON: j, Valid: true}
+}
+
+// ParseNullJSON returns the NullJSON for JSON text, see Scan.
+func ParseNullJSON(s string) (NullJSON, error) {
+	var nj NullJSON
+	if err := nj.Scan(s); err != nil {
+		return NullJSON{}, err
+	}
+	return nj, nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts the same values as
+// JSON.Scan. NULL, empty text and the JSON literal null set Valid to false.
+func (nj *NullJSON) Scan(value interface{}) error {
+	var j JSON
+	if err := j.Scan(value); err != nil {
+		nj.JSON, nj.Valid = nil, false
+		return err
+	}
+	nj.JSON, nj.Valid = j, j != nil
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns NULL if nj is not valid, otherwise the JSON text like JSON.Value.
+func (nj NullJSON) Value() (driver.Value, error) {
+	if !nj.Valid {
+		return nil, nil
+	}
+	if nj.JSON == nil {
+		return "{}", nil
+	}
+	return nj.JSON.Value()
+}
+
+// Custom function used by the gorm ORM if used.
+func (nj NullJSON) GormDataType() string {
+	return "jsonb"
+}
+
+// MarshalJSON marshals a valid NullJSON as its object and a NULL one as null.
+func (nj NullJSON) MarshalJSON() ([]byte, error) {
+	if !nj.Valid {
+		return []byte("null"), nil
+	}
+	if nj.JSON == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(map[string]interface{}(nj.JSON))
+}
+
+// UnmarshalJSON sets nj to NULL for null, otherwise it decodes the object
+// like JSON.UnmarshalJSON.
+func (nj *NullJSON) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		nj.JSON, nj.Valid = nil, false
+		return nil
+	}
+
+	var j JSON
+	if err := j.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	nj.JSON, nj.Valid = j, true
+	return nil
+}
+
+// FormScan implements the FormScanner interface (see JSON.FormScan).
+// An empty string sets nj to NULL.
+func (nj *NullJSON) FormScan(value interface{}) error {
+	if s, ok := value.(string); ok && strings.TrimSpace(s) == "" {
+		nj.JSON, nj.Valid = nil, false
+		return nil
+	}
+
+	var j JSON
+	if err := j.FormScan(value); err != nil {
+		return err
+	}
+	nj.JSON, nj.Valid = j, true
+	return nil
+}
+
+// GobEncode encodes nj as a validity byte followed by the gob-encoded JSON.
+func (nj NullJSON) GobEncode() ([]byte, error) {
+	if !nj.Valid {
+		return []byte{0}, nil
+	}
+
+	b, err := nj.JSON.GobEncode()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{1}, b...), nil
+}
+
+// GobDecode decodes data produced by GobEncode.
+func (nj *NullJSON) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("error decoding NullJSON: no data")
+	}
+
+	if data[0] == 0 {
+		nj.JSON, nj.Valid = nil, false
+		return nil
+	}
+
+	var j JSON
+	if err := j.GobDecode(data[1:]); err != nil {
+		return err
+	}
+	if j == nil {
+		j = JSON{}
+	}
+	nj.JSON, nj.Valid = j, true
+	return nil
+}
+
+// Get returns the value at path (see JSON.Get).
+// ok is false if nj is NULL.
+func (nj NullJSON) Get(path string) (value interface{}, ok bool) {
+	if !nj.Valid {
+		return nil, false
+	}
+	return nj.JSON.Get(path)
+}
+
+// Set sets the value at path (see JSON.Set). A NULL nj becomes
+// a valid empty object first.
+func (nj *NullJSON) Set(path string, value interface{}) error {
+	j := nj.JSON
+	if !nj.Valid {
+		j = nil
+	}
+	if err := j.Set(path, value); err != nil {
+		return err
+	}
+	nj.JSON, nj.Valid = j, true
+	return nil
+}
+
+// Delete removes the value at path (see JSON.Delete) and reports whether
+// it existed. It returns false if nj is NULL.
+func (nj NullJSON) Delete(path string) bool {
+	if !nj.Valid {
+		return false
+	}
+	return nj.JSON.Delete(path)
+}
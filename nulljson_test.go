@@ -0,0 +1,204 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestNullJSONScan(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		want      dbtypes.JSON
+		wantValid bool
+	}{
+		{"NULL", nil, nil, false},
+		{"null literal", "null", nil, false},
+		{"empty text", []byte(""), nil, false},
+		{"empty object", "{}", dbtypes.JSON{}, true},
+		{"object", []byte(`{"a":1}`), dbtypes.JSON{"a": 1.0}, true},
+		{"map", map[string]interface{}{"a": "b"}, dbtypes.JSON{"a": "b"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nj := dbtypes.NullJSONFrom(dbtypes.JSON{"stale": true})
+			if err := nj.Scan(tt.value); err != nil {
+				t.Fatalf("Scan() returned error: %v", err)
+			}
+			if nj.Valid != tt.wantValid || !reflect.DeepEqual(nj.JSON, tt.want) {
+				t.Errorf("Scan() = %#v, %v, want %#v, %v", nj.JSON, nj.Valid, tt.want, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestNullJSONScanErrors(t *testing.T) {
+	nj := dbtypes.NullJSONFrom(dbtypes.JSON{"stale": true})
+	if err := nj.Scan(`[1]`); err == nil {
+		t.Errorf("Scan([1]) returned no error")
+	}
+	if nj.Valid {
+		t.Errorf("Scan() Valid = true after an error")
+	}
+
+	var typeErr *dbtypes.ScanTypeError
+	if err := nj.Scan(42); !errors.As(err, &typeErr) {
+		t.Errorf("Scan(42) = %v, want *ScanTypeError", err)
+	}
+}
+
+func TestNullJSONValue(t *testing.T) {
+	tests := []struct {
+		name string
+		nj   dbtypes.NullJSON
+		want interface{}
+	}{
+		{"NULL", dbtypes.NullJSON{}, nil},
+		{"NULL with object", dbtypes.NullJSON{JSON: dbtypes.JSON{"a": 1}}, nil},
+		{"nil object", dbtypes.NullJSONFrom(nil), "{}"},
+		{"empty object", dbtypes.NullJSONFrom(dbtypes.JSON{}), "{}"},
+		{"object", dbtypes.NullJSONFrom(dbtypes.JSON{"a": 1}), `{"a":1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.nj.Value()
+			if err != nil {
+				t.Fatalf("Value() returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullJSONMarshalJSON(t *testing.T) {
+	type payload struct {
+		Meta dbtypes.NullJSON `json:"meta"`
+	}
+
+	tests := []struct {
+		nj   dbtypes.NullJSON
+		want string
+	}{
+		{dbtypes.NullJSON{}, `{"meta":null}`},
+		{dbtypes.NullJSONFrom(nil), `{"meta":{}}`},
+		{dbtypes.NullJSONFrom(dbtypes.JSON{"a": "b"}), `{"meta":{"a":"b"}}`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(payload{Meta: tt.nj})
+		if err != nil {
+			t.Fatalf("Marshal() returned error: %v", err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("Marshal() = %s, want %s", data, tt.want)
+		}
+
+		var got payload
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s) returned error: %v", data, err)
+		}
+		if got.Meta.Valid != tt.nj.Valid || jsonString(t, got.Meta) != jsonString(t, tt.nj) {
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", data, got.Meta, tt.nj)
+		}
+	}
+
+	var nj dbtypes.NullJSON
+	if err := json.Unmarshal([]byte(`[1]`), &nj); err == nil {
+		t.Errorf("Unmarshal([1]) returned no error")
+	}
+}
+
+func TestNullJSONFormScan(t *testing.T) {
+	nj := dbtypes.NullJSONFrom(dbtypes.JSON{"stale": true})
+	if err := nj.FormScan(""); err != nil || nj.Valid {
+		t.Errorf(`FormScan("") = %v, Valid %v, want nil, false`, err, nj.Valid)
+	}
+	if err := nj.FormScan(`{"a":"b"}`); err != nil || !nj.Valid || nj.JSON["a"] != "b" {
+		t.Errorf("FormScan() = %v, %+v", err, nj)
+	}
+}
+
+func TestNullJSONGob(t *testing.T) {
+	values := []dbtypes.NullJSON{
+		{},
+		dbtypes.NullJSONFrom(dbtypes.JSON{}),
+		dbtypes.NullJSONFrom(dbtypes.JSON{"a": "b", "n": []interface{}{1.0}}),
+	}
+	for _, nj := range values {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(nj); err != nil {
+			t.Fatalf("gob Encode() returned error: %v", err)
+		}
+
+		var got dbtypes.NullJSON
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("gob Decode() returned error: %v", err)
+		}
+		if !reflect.DeepEqual(got, nj) {
+			t.Errorf("gob round trip = %#v, want %#v", got, nj)
+		}
+	}
+}
+
+func TestNullJSONPaths(t *testing.T) {
+	var nj dbtypes.NullJSON
+	if _, ok := nj.Get("a"); ok {
+		t.Errorf("NULL Get() ok = true")
+	}
+	if nj.Delete("a") {
+		t.Errorf("NULL Delete() = true")
+	}
+
+	if err := nj.Set("meta.author", "ann"); err != nil {
+		t.Fatalf("Set() returned error: %v", err)
+	}
+	if !nj.Valid {
+		t.Errorf("Set() left NullJSON NULL")
+	}
+	if got, _ := nj.Get("meta.author"); got != "ann" {
+		t.Errorf("Get() = %v, want ann", got)
+	}
+	if !nj.Delete("meta.author") {
+		t.Errorf("Delete() = false, want true")
+	}
+
+	// A failed Set leaves a NULL value unchanged.
+	null := dbtypes.NullJSON{}
+	if err := null.Set("a[1]", 1); !errors.Is(err, dbtypes.ErrInvalidPath) {
+		t.Errorf("Set(a[1]) = %v, want ErrInvalidPath", err)
+	}
+	if null.Valid {
+		t.Errorf("failed Set() made NullJSON valid")
+	}
+}
+
+func TestNullJSONDatabase(t *testing.T) {
+	db := openFakeDB(t)
+
+	values := []dbtypes.NullJSON{
+		{},
+		dbtypes.NullJSONFrom(dbtypes.JSON{}),
+		dbtypes.NullJSONFrom(dbtypes.JSON{"a": "b", "tags": []interface{}{"x"}}),
+	}
+	if _, err := db.Exec("INSERT", values[0], values[1], values[2]); err != nil {
+		t.Fatalf("Exec() returned error: %v", err)
+	}
+
+	got := make([]dbtypes.NullJSON, len(values))
+	if err := db.QueryRow("SELECT").Scan(&got[0], &got[1], &got[2]); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("Scan() = %#v, want %#v", got, values)
+	}
+}
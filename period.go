@@ -0,0 +1,272 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPeriod is returned when parsing a malformed ISO 8601 period.
+var ErrInvalidPeriod = errors.New("period should be of the ISO 8601 format: PnYnMnD")
+
+// Period is a calendar duration expressed in years, months and days.
+// Unlike time.Duration, the length of a Period depends on the dates
+// it is applied to since months and years vary in length.
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// Since returns the calendar period elapsed from other to the date,
+// e.g. for a tenancy that started on other and ends on the date.
+//
+// Whole months are counted first with end-of-month clamping, so Jan 31 to
+// Feb 28 is 1 month, and the remaining days are counted after that.
+// If the date is before other, all components of the result are negative.
+func (date Date) Since(other Date) Period {
+	if date.Before(other) {
+		return other.Since(date).Neg()
+	}
+
+	sy, sm, sd := other.civil()
+	ey, em, ed := date.civil()
+	end := civilDaysOf(ey, em, ed)
+
+	months := (ey-sy)*12 + int(em-sm)
+	y, m, d := addMonthsClamped(sy, sm, sd, months)
+	if civilDaysOf(y, m, d) > end {
+		months--
+		y, m, d = addMonthsClamped(sy, sm, sd, months)
+	}
+
+	return Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   int(end - civilDaysOf(y, m, d)),
+	}
+}
+
+// IsZero reports whether all components of the period are zero.
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Days == 0
+}
+
+// ParsePeriod parses an ISO 8601 period of years, months, weeks and days
+// like "P1Y2M3D", "P30D" or "P2W". Weeks are normalized to days, so "P1W2D"
+// is Period{Days: 9}. Components may be signed individually ("P1Y-2M") and a
+// leading sign applies to the whole period ("-P1Y2M"). Designators must appear
+// in the order Y, M, W, D and time components ("PT12H") are not supported.
+// Errors wrap ErrInvalidPeriod.
+func ParsePeriod(s string) (Period, error) {
+	invalid := fmt.Errorf("%w: %q", ErrInvalidPeriod, s)
+
+	rest, sign := s, 1
+	if r, ok := strings.CutPrefix(rest, "-"); ok {
+		rest, sign = r, -1
+	} else {
+		rest = strings.TrimPrefix(rest, "+")
+	}
+
+	rest, ok := strings.CutPrefix(rest, "P")
+	if !ok || rest == "" {
+		return Period{}, invalid
+	}
+
+	var p Period
+	designators := "YMWD"
+	for rest != "" {
+		end := 0
+		if rest[0] == '-' || rest[0] == '+' {
+			end++
+		}
+		for end < len(rest) && '0' <= rest[end] && rest[end] <= '9' {
+			end++
+		}
+		if end == 0 || end == len(rest) || (end == 1 && (rest[0] == '-' || rest[0] == '+')) {
+			return Period{}, invalid
+		}
+
+		n, err := strconv.Atoi(rest[:end])
+		if err != nil {
+			return Period{}, invalid
+		}
+
+		i := strings.IndexByte(designators, rest[end])
+		if i < 0 {
+			return Period{}, invalid
+		}
+		switch designators[i] {
+		case 'Y':
+			p.Years = sign * n
+		case 'M':
+			p.Months = sign * n
+		case 'W':
+			p.Days += sign * n * 7
+		case 'D':
+			p.Days += sign * n
+		}
+		designators = designators[i+1:]
+		rest = rest[end+1:]
+	}
+	return p, nil
+}
+
+// String returns the period in canonical ISO 8601 form like "P1Y2M3D".
+// Zero components are omitted and a zero period is "P0D".
+// Negative components keep their sign, e.g. "P-1Y-2D", so that the result
+// parses back to the same period with ParsePeriod.
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	b := []byte{'P'}
+	if p.Years != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Years), 10), 'Y')
+	}
+	if p.Months != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Months), 10), 'M')
+	}
+	if p.Days != 0 {
+		b = append(strconv.AppendInt(b, int64(p.Days), 10), 'D')
+	}
+	return string(b)
+}
+
+// Neg returns the period with all components negated.
+func (p Period) Neg() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Days: -p.Days}
+}
+
+// Scan implements the sql.Scanner interface for periods stored as ISO 8601 strings.
+// NULL scans as the zero period.
+func (p *Period) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*p = Period{}
+		return nil
+	case string:
+		return p.parse(v)
+	case []byte:
+		return p.parse(string(v))
+	}
+	return fmt.Errorf("cannot scan %T into Period", value)
+}
+
+// Value implements the driver.Valuer interface, storing the ISO 8601 string.
+func (p Period) Value() (driver.Value, error) {
+	return p.String(), nil
+}
+
+// MarshalJSON marshals the period as an ISO 8601 string.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON parses an ISO 8601 period string. null is a no-op.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPeriod, data)
+	}
+	return p.parse(s)
+}
+
+func (p *Period) parse(s string) error {
+	parsed, err := ParsePeriod(s)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// AddPeriod returns the date advanced by p. Years and months are added first,
+// clamping the day to the end of a shorter month (Jan 31 + P1M is Feb 28),
+// and days after that. For a date on or after other, this is the inverse of
+// Since: other.AddPeriod(date.Since(other)) equals date.
+// The zero date is returned unchanged.
+func (date Date) AddPeriod(p Period) Date {
+	if date.IsZero() {
+		return date
+	}
+
+	y, m, d := date.civil()
+	y, m, d = addMonthsClamped(y, m, d, p.Years*12+p.Months)
+	return NewDateIn(y, m, d+p.Days, time.Time(date).Location())
+}
+
+// SubPeriod returns the date moved back by p, i.e. date.AddPeriod(p.Neg()).
+func (date Date) SubPeriod(p Period) Date {
+	return date.AddPeriod(p.Neg())
+}
+
+// LongString returns a human readable form of the period like "2 years, 3 months, 12 days".
+// Zero components are omitted and a zero period is "0 days".
+// Negative periods are prefixed with a minus sign, e.g. "-1 year, 2 days".
+func (p Period) LongString() string {
+	sign := ""
+	if p.Years < 0 || p.Months < 0 || p.Days < 0 {
+		sign = "-"
+		p = Period{Years: abs(p.Years), Months: abs(p.Months), Days: abs(p.Days)}
+	}
+
+	var parts []string
+	if p.Years != 0 {
+		parts = append(parts, pluralize(p.Years, "year"))
+	}
+	if p.Months != 0 {
+		parts = append(parts, pluralize(p.Months, "month"))
+	}
+	if p.Days != 0 || len(parts) == 0 {
+		parts = append(parts, pluralize(p.Days, "day"))
+	}
+	return sign + strings.Join(parts, ", ")
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// MonthsBetween returns the number of completed calendar months between
+// the date and other, regardless of their order.
+//
+// Months are counted from the earlier date with end-of-month clamping:
+// Jan 31 to Feb 28 (or Feb 29 in a leap year) counts as 1 month,
+// while Jan 30 to Feb 28 in a leap year does not.
+func (date Date) MonthsBetween(other Date) int {
+	start, end := date, other
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	p := end.Since(start)
+	return p.Years*12 + p.Months
+}
+
+// YearsBetween returns the number of completed calendar years between
+// the date and other, regardless of their order.
+// Feb 29 to Feb 28 of the following year counts as 1 year.
+func (date Date) YearsBetween(other Date) int {
+	return date.MonthsBetween(other) / 12
+}
@@ -0,0 +1,311 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateSince(t *testing.T) {
+	tests := []struct {
+		name  string
+		start dbtypes.Date
+		end   dbtypes.Date
+		want  dbtypes.Period
+	}{
+		{
+			name:  "same day",
+			start: dbtypes.NewDate(2023, time.October, 21),
+			end:   dbtypes.NewDate(2023, time.October, 21),
+			want:  dbtypes.Period{},
+		},
+		{
+			name:  "years months and days",
+			start: dbtypes.NewDate(2020, time.July, 3),
+			end:   dbtypes.NewDate(2022, time.October, 15),
+			want:  dbtypes.Period{Years: 2, Months: 3, Days: 12},
+		},
+		{
+			name:  "borrow days across variable month lengths",
+			start: dbtypes.NewDate(2023, time.January, 20),
+			end:   dbtypes.NewDate(2023, time.March, 5),
+			want:  dbtypes.Period{Months: 1, Days: 13},
+		},
+		{
+			name:  "Jan 31 to Feb 28",
+			start: dbtypes.NewDate(2023, time.January, 31),
+			end:   dbtypes.NewDate(2023, time.February, 28),
+			want:  dbtypes.Period{Months: 1},
+		},
+		{
+			name:  "Jan 31 to Feb 29 in leap year",
+			start: dbtypes.NewDate(2024, time.January, 31),
+			end:   dbtypes.NewDate(2024, time.February, 29),
+			want:  dbtypes.Period{Months: 1},
+		},
+		{
+			name:  "Jan 31 to Feb 28 in leap year",
+			start: dbtypes.NewDate(2024, time.January, 31),
+			end:   dbtypes.NewDate(2024, time.February, 28),
+			want:  dbtypes.Period{Days: 28},
+		},
+		{
+			name:  "Jan 31 to Mar 1",
+			start: dbtypes.NewDate(2023, time.January, 31),
+			end:   dbtypes.NewDate(2023, time.March, 1),
+			want:  dbtypes.Period{Months: 1, Days: 1},
+		},
+		{
+			name:  "exactly one year",
+			start: dbtypes.NewDate(2022, time.March, 1),
+			end:   dbtypes.NewDate(2023, time.March, 1),
+			want:  dbtypes.Period{Years: 1},
+		},
+		{
+			name:  "across year boundary",
+			start: dbtypes.NewDate(2022, time.December, 25),
+			end:   dbtypes.NewDate(2023, time.January, 5),
+			want:  dbtypes.Period{Days: 11},
+		},
+		{
+			name:  "negative when receiver is earlier",
+			start: dbtypes.NewDate(2022, time.October, 15),
+			end:   dbtypes.NewDate(2020, time.July, 3),
+			want:  dbtypes.Period{Years: -2, Months: -3, Days: -12},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.end.Since(tt.start); got != tt.want {
+				t.Errorf("%s.Since(%s) = %+v, want %+v", tt.end, tt.start, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodLongString(t *testing.T) {
+	tests := []struct {
+		period dbtypes.Period
+		want   string
+	}{
+		{period: dbtypes.Period{}, want: "0 days"},
+		{period: dbtypes.Period{Years: 2, Months: 3, Days: 12}, want: "2 years, 3 months, 12 days"},
+		{period: dbtypes.Period{Years: 1, Months: 1, Days: 1}, want: "1 year, 1 month, 1 day"},
+		{period: dbtypes.Period{Months: 6}, want: "6 months"},
+		{period: dbtypes.Period{Years: 1, Days: 2}, want: "1 year, 2 days"},
+		{period: dbtypes.Period{Years: -1, Days: -2}, want: "-1 year, 2 days"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.period.LongString(); got != tt.want {
+			t.Errorf("%+v.LongString() = %q, want %q", tt.period, got, tt.want)
+		}
+	}
+}
+
+func TestDateMonthsYearsBetween(t *testing.T) {
+	tests := []struct {
+		name       string
+		date       dbtypes.Date
+		other      dbtypes.Date
+		wantMonths int
+		wantYears  int
+	}{
+		{name: "same day", date: dbtypes.NewDate(2023, time.May, 5), other: dbtypes.NewDate(2023, time.May, 5)},
+		{name: "one day short of a month", date: dbtypes.NewDate(2023, time.May, 5), other: dbtypes.NewDate(2023, time.June, 4)},
+		{name: "exactly one month", date: dbtypes.NewDate(2023, time.May, 5), other: dbtypes.NewDate(2023, time.June, 5), wantMonths: 1},
+		{name: "Jan 31 to Feb 28", date: dbtypes.NewDate(2023, time.January, 31), other: dbtypes.NewDate(2023, time.February, 28), wantMonths: 1},
+		{name: "Jan 31 to Feb 28 leap year", date: dbtypes.NewDate(2024, time.January, 31), other: dbtypes.NewDate(2024, time.February, 28)},
+		{name: "Jan 31 to Feb 29 leap year", date: dbtypes.NewDate(2024, time.January, 31), other: dbtypes.NewDate(2024, time.February, 29), wantMonths: 1},
+		{name: "Jan 30 to Feb 28", date: dbtypes.NewDate(2023, time.January, 30), other: dbtypes.NewDate(2023, time.February, 28), wantMonths: 1},
+		{name: "Mar 31 to Apr 30", date: dbtypes.NewDate(2023, time.March, 31), other: dbtypes.NewDate(2023, time.April, 30), wantMonths: 1},
+		{name: "Apr 30 to May 30", date: dbtypes.NewDate(2023, time.April, 30), other: dbtypes.NewDate(2023, time.May, 30), wantMonths: 1},
+		{name: "11 months", date: dbtypes.NewDate(2022, time.February, 1), other: dbtypes.NewDate(2023, time.January, 31), wantMonths: 11},
+		{name: "one year", date: dbtypes.NewDate(2022, time.February, 1), other: dbtypes.NewDate(2023, time.February, 1), wantMonths: 12, wantYears: 1},
+		{name: "leap day to Feb 28", date: dbtypes.NewDate(2020, time.February, 29), other: dbtypes.NewDate(2021, time.February, 28), wantMonths: 12, wantYears: 1},
+		{name: "leap day to leap day", date: dbtypes.NewDate(2020, time.February, 29), other: dbtypes.NewDate(2024, time.February, 29), wantMonths: 48, wantYears: 4},
+		{name: "reversed order", date: dbtypes.NewDate(2023, time.June, 5), other: dbtypes.NewDate(2021, time.May, 5), wantMonths: 25, wantYears: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.MonthsBetween(tt.other); got != tt.wantMonths {
+				t.Errorf("MonthsBetween() = %d, want %d", got, tt.wantMonths)
+			}
+			if got := tt.date.YearsBetween(tt.other); got != tt.wantYears {
+				t.Errorf("YearsBetween() = %d, want %d", got, tt.wantYears)
+			}
+		})
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    dbtypes.Period
+		wantStr string
+		wantErr bool
+	}{
+		{input: "P1Y2M3D", want: dbtypes.Period{Years: 1, Months: 2, Days: 3}, wantStr: "P1Y2M3D"},
+		{input: "P1Y6M", want: dbtypes.Period{Years: 1, Months: 6}, wantStr: "P1Y6M"},
+		{input: "P30D", want: dbtypes.Period{Days: 30}, wantStr: "P30D"},
+		{input: "P0D", want: dbtypes.Period{}, wantStr: "P0D"},
+		{input: "P0Y0M0D", want: dbtypes.Period{}, wantStr: "P0D"},
+		{input: "P2W", want: dbtypes.Period{Days: 14}, wantStr: "P14D"},
+		{input: "P1M1W2D", want: dbtypes.Period{Months: 1, Days: 9}, wantStr: "P1M9D"},
+		{input: "P-1Y2M", want: dbtypes.Period{Years: -1, Months: 2}, wantStr: "P-1Y2M"},
+		{input: "-P1Y2M", want: dbtypes.Period{Years: -1, Months: -2}, wantStr: "P-1Y-2M"},
+		{input: "+P3D", want: dbtypes.Period{Days: 3}, wantStr: "P3D"},
+		{input: "", wantErr: true},
+		{input: "P", wantErr: true},
+		{input: "1Y", wantErr: true},
+		{input: "P1D1Y", wantErr: true},
+		{input: "P1Y1Y", wantErr: true},
+		{input: "P1.5Y", wantErr: true},
+		{input: "PT12H", wantErr: true},
+		{input: "P1", wantErr: true},
+		{input: "P-D", wantErr: true},
+		{input: "p1d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := dbtypes.ParsePeriod(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, dbtypes.ErrInvalidPeriod) {
+					t.Fatalf("ParsePeriod(%q) error = %v, want %v", tt.input, err, dbtypes.ErrInvalidPeriod)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePeriod(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePeriod(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+			if got.String() != tt.wantStr {
+				t.Errorf("ParsePeriod(%q).String() = %q, want %q", tt.input, got.String(), tt.wantStr)
+			}
+
+			roundTrip, err := dbtypes.ParsePeriod(got.String())
+			if err != nil || roundTrip != got {
+				t.Errorf("ParsePeriod(%q) = %+v, %v, want %+v", got.String(), roundTrip, err, got)
+			}
+		})
+	}
+}
+
+func TestDateAddSubPeriod(t *testing.T) {
+	tests := []struct {
+		date    string
+		period  string
+		wantAdd string
+		wantSub string
+	}{
+		{date: "2023-01-15", period: "P1Y6M", wantAdd: "2024-07-15", wantSub: "2021-07-15"},
+		{date: "2023-01-31", period: "P1M", wantAdd: "2023-02-28", wantSub: "2022-12-31"},
+		{date: "2024-02-29", period: "P1Y", wantAdd: "2025-02-28", wantSub: "2023-02-28"},
+		{date: "2023-01-31", period: "P1M1D", wantAdd: "2023-03-01", wantSub: "2022-12-30"},
+		{date: "2023-12-25", period: "P2W", wantAdd: "2024-01-08", wantSub: "2023-12-11"},
+		{date: "2023-06-15", period: "P0D", wantAdd: "2023-06-15", wantSub: "2023-06-15"},
+		{date: "2023-06-15", period: "-P1M", wantAdd: "2023-05-15", wantSub: "2023-07-15"},
+	}
+
+	for _, tt := range tests {
+		date := dbtypes.MustParseDate(tt.date)
+		p, err := dbtypes.ParsePeriod(tt.period)
+		if err != nil {
+			t.Fatalf("ParsePeriod(%q) returned error: %v", tt.period, err)
+		}
+
+		if got := date.AddPeriod(p); got.String() != tt.wantAdd {
+			t.Errorf("%s.AddPeriod(%s) = %s, want %s", date, p, got, tt.wantAdd)
+		}
+		if got := date.SubPeriod(p); got.String() != tt.wantSub {
+			t.Errorf("%s.SubPeriod(%s) = %s, want %s", date, p, got, tt.wantSub)
+		}
+	}
+
+	if got := (dbtypes.Date{}).AddPeriod(dbtypes.Period{Days: 1}); !got.IsZero() {
+		t.Errorf("zero AddPeriod() = %s, want zero date", got)
+	}
+}
+
+func TestDateAddPeriodInvertsSince(t *testing.T) {
+	start := dbtypes.MustParseDate("2023-01-31")
+	for end := start; end.Before(dbtypes.MustParseDate("2025-03-01")); end = end.AddDays(3) {
+		if got := start.AddPeriod(end.Since(start)); !got.Equal(end) {
+			t.Errorf("%s.AddPeriod(%s) = %s, want %s", start, end.Since(start), got, end)
+		}
+	}
+}
+
+func TestPeriodSQLAndJSON(t *testing.T) {
+	type contract struct {
+		Term dbtypes.Period `json:"term"`
+	}
+
+	tests := []dbtypes.Period{
+		{Years: 1, Months: 6},
+		{Days: 30},
+		{},
+		{Years: -1, Days: 2},
+	}
+
+	db := openFakeDB(t)
+	for _, p := range tests {
+		if _, err := db.Exec("INSERT INTO periods VALUES (?)", p); err != nil {
+			t.Fatalf("Exec() returned error: %v", err)
+		}
+
+		data, err := json.Marshal(contract{Term: p})
+		if err != nil {
+			t.Fatalf("json.Marshal(%s) returned error: %v", p, err)
+		}
+		if want := `{"term":"` + p.String() + `"}`; string(data) != want {
+			t.Errorf("json.Marshal(%+v) = %s, want %s", p, data, want)
+		}
+
+		var got contract
+		if err := json.Unmarshal(data, &got); err != nil || got.Term != p {
+			t.Errorf("json.Unmarshal(%s) = %+v, %v, want %+v", data, got.Term, err, p)
+		}
+	}
+
+	rows, err := db.Query("SELECT * FROM periods")
+	if err != nil {
+		t.Fatalf("Query() returned error: %v", err)
+	}
+	defer rows.Close()
+
+	var got []dbtypes.Period
+	for rows.Next() {
+		var p dbtypes.Period
+		if err := rows.Scan(&p); err != nil {
+			t.Fatalf("Scan() returned error: %v", err)
+		}
+		got = append(got, p)
+	}
+	if !slices.Equal(got, tests) {
+		t.Errorf("scanned periods = %v, want %v", got, tests)
+	}
+
+	var p dbtypes.Period
+	if err := p.Scan(nil); err != nil || !p.IsZero() {
+		t.Errorf("Scan(nil) = %+v, %v, want zero period", p, err)
+	}
+	if err := p.Scan(42); err == nil {
+		t.Errorf("Scan(42) error = nil, want error")
+	}
+	if err := json.Unmarshal([]byte(`"P1X"`), &p); !errors.Is(err, dbtypes.ErrInvalidPeriod) {
+		t.Errorf("json.Unmarshal(P1X) error = %v, want %v", err, dbtypes.ErrInvalidPeriod)
+	}
+	if err := json.Unmarshal([]byte(`12`), &p); !errors.Is(err, dbtypes.ErrInvalidPeriod) {
+		t.Errorf("json.Unmarshal(12) error = %v, want %v", err, dbtypes.ErrInvalidPeriod)
+	}
+}
@@ -0,0 +1,104 @@
+package dbtypes
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrInfiniteDate is returned when scanning a PostgreSQL infinity or -infinity date.
+var ErrInfiniteDate = errors.New("date is infinite")
+
+// ScanDate implements the pgtype.DateScanner interface, letting pgx v5 scan
+// date columns in both the text and binary protocols.
+// NULL scans as the zero date and infinity or -infinity as ErrInfiniteDate.
+func (date *Date) ScanDate(v pgtype.Date) error {
+	if !v.Valid {
+		*date = Date{}
+		return nil
+	}
+
+	if v.InfinityModifier != pgtype.Finite {
+		return &ParseError{Input: v.InfinityModifier.String(), Layout: DateLayout, Err: ErrInfiniteDate}
+	}
+
+	y, m, d := v.Time.Date()
+	*date = Date(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	return nil
+}
+
+// DateValue implements the pgtype.DateValuer interface.
+// Like Value, zero dates are NULL only if ZeroDateAsNull(true) has been called.
+func (date Date) DateValue() (pgtype.Date, error) {
+	if zeroDateAsNull && date.IsZero() {
+		return pgtype.Date{}, nil
+	}
+
+	y, m, d := date.civil()
+	return pgtype.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}, nil
+}
+
+// ScanText implements the pgtype.TextScanner interface, letting Date be
+// scanned from text and varchar columns or text values in COPY pipelines.
+// NULL scans as the zero date; other values are parsed like Scan does for
+// strings, and infinity or -infinity result in ErrInfiniteDate.
+func (date *Date) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		*date = Date{}
+		return nil
+	}
+
+	switch s := strings.TrimSpace(v.String); s {
+	case "infinity", "-infinity":
+		return &ParseError{Input: s, Layout: DateLayout, Err: ErrInfiniteDate}
+	default:
+		return date.scanText(s)
+	}
+}
+
+// TextValue implements the pgtype.TextValuer interface, encoding the date as
+// yyyy-mm-dd text. Like Value, zero dates are NULL only if ZeroDateAsNull(true)
+// has been called.
+func (date Date) TextValue() (pgtype.Text, error) {
+	if zeroDateAsNull && date.IsZero() {
+		return pgtype.Text{}, nil
+	}
+	return pgtype.Text{String: time.Time(date).Format(DateLayout), Valid: true}, nil
+}
+
+// ScanDate implements the pgtype.DateScanner interface.
+func (nd *NullDate) ScanDate(v pgtype.Date) error {
+	if err := nd.Date.ScanDate(v); err != nil {
+		nd.Valid = false
+		return err
+	}
+	nd.Valid = v.Valid
+	return nil
+}
+
+// DateValue implements the pgtype.DateValuer interface.
+func (nd NullDate) DateValue() (pgtype.Date, error) {
+	if !nd.Valid {
+		return pgtype.Date{}, nil
+	}
+
+	y, m, d := nd.Date.civil()
+	return pgtype.Date{Time: time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Valid: true}, nil
+}
+
+// RegisterPgxTypes registers Date and NullDate as PostgreSQL date values in m,
+// so that pgx encodes them as dates even when the parameter type is unknown,
+// e.g. with the simple protocol or CopyFrom.
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		dbtypes.RegisterPgxTypes(conn.TypeMap())
+//		return nil
+//	}
+func RegisterPgxTypes(m *pgtype.Map) {
+	m.RegisterDefaultPgType(Date{}, "date")
+	m.RegisterDefaultPgType(&Date{}, "date")
+	m.RegisterDefaultPgType(NullDate{}, "date")
+	m.RegisterDefaultPgType(&NullDate{}, "date")
+}
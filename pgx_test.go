@@ -0,0 +1,191 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestDatePgxRoundTrip(t *testing.T) {
+	m := pgtype.NewMap()
+	dbtypes.RegisterPgxTypes(m)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dates := []dbtypes.Date{
+		dbtypes.NewDateUTC(2015, time.October, 21),
+		dbtypes.NewDateIn(2015, time.October, 21, newYork),
+		dbtypes.NewDateUTC(1969, time.December, 31),
+		dbtypes.NewDateUTC(2000, time.February, 29),
+	}
+
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		for _, date := range dates {
+			buf, err := m.Encode(pgtype.DateOID, format, date, nil)
+			if err != nil {
+				t.Fatalf("Encode(%s, %d) returned error: %v", date, format, err)
+			}
+			if format == pgtype.TextFormatCode && string(buf) != date.String() {
+				t.Errorf("Encode(%s) text = %q, want %q", date, buf, date.String())
+			}
+
+			var got dbtypes.Date
+			if err := m.Scan(pgtype.DateOID, format, buf, &got); err != nil {
+				t.Fatalf("Scan(%q, %d) returned error: %v", buf, format, err)
+			}
+			if got.String() != date.String() {
+				t.Errorf("pgx round trip of %s in format %d = %s", date, format, got)
+			}
+
+			var nd dbtypes.NullDate
+			if err := m.Scan(pgtype.DateOID, format, buf, &nd); err != nil || !nd.Valid || nd.String() != date.String() {
+				t.Errorf("Scan into NullDate = %+v, %v, want %s", nd, err, date)
+			}
+		}
+	}
+}
+
+func TestDatePgxNull(t *testing.T) {
+	defer dbtypes.ZeroDateAsNull(false)
+	m := pgtype.NewMap()
+
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		got := dbtypes.Today()
+		if err := m.Scan(pgtype.DateOID, format, nil, &got); err != nil || !got.IsZero() {
+			t.Errorf("Scan(NULL) = %s, %v, want zero date", got, err)
+		}
+
+		nd := dbtypes.NullDateFrom(dbtypes.Today())
+		if err := m.Scan(pgtype.DateOID, format, nil, &nd); err != nil || nd.Valid {
+			t.Errorf("Scan(NULL) into NullDate = %+v, %v, want invalid", nd, err)
+		}
+
+		buf, err := m.Encode(pgtype.DateOID, format, dbtypes.NullDate{}, nil)
+		if err != nil || buf != nil {
+			t.Errorf("Encode(NullDate{}) = %q, %v, want NULL", buf, err)
+		}
+
+		dbtypes.ZeroDateAsNull(false)
+		buf, err = m.Encode(pgtype.DateOID, format, dbtypes.Date{}, nil)
+		if err != nil || buf == nil {
+			t.Errorf("Encode(zero date) = %v, %v, want 0001-01-01", buf, err)
+		}
+
+		dbtypes.ZeroDateAsNull(true)
+		buf, err = m.Encode(pgtype.DateOID, format, dbtypes.Date{}, nil)
+		if err != nil || buf != nil {
+			t.Errorf("Encode(zero date) with ZeroDateAsNull = %q, %v, want NULL", buf, err)
+		}
+	}
+}
+
+func TestDatePgxInfinity(t *testing.T) {
+	m := pgtype.NewMap()
+
+	for _, modifier := range []pgtype.InfinityModifier{pgtype.Infinity, pgtype.NegativeInfinity} {
+		for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+			buf, err := m.Encode(pgtype.DateOID, format, pgtype.Date{InfinityModifier: modifier, Valid: true}, nil)
+			if err != nil {
+				t.Fatalf("Encode(%s) returned error: %v", modifier, err)
+			}
+
+			var got dbtypes.Date
+			if err := m.Scan(pgtype.DateOID, format, buf, &got); !errors.Is(err, dbtypes.ErrInfiniteDate) {
+				t.Errorf("Scan(%q) error = %v, want %v", buf, err, dbtypes.ErrInfiniteDate)
+			}
+		}
+	}
+}
+
+func TestRegisterPgxTypes(t *testing.T) {
+	m := pgtype.NewMap()
+	dbtypes.RegisterPgxTypes(m)
+
+	for _, value := range []any{dbtypes.Date{}, &dbtypes.Date{}, dbtypes.NullDate{}, &dbtypes.NullDate{}} {
+		typ, ok := m.TypeForValue(value)
+		if !ok || typ.OID != pgtype.DateOID {
+			t.Errorf("TypeForValue(%T) = %v, %v, want date", value, typ, ok)
+		}
+	}
+}
+
+func TestDateScanText(t *testing.T) {
+	tests := []struct {
+		text    pgtype.Text
+		want    string
+		wantErr error
+	}{
+		{text: pgtype.Text{String: "2015-10-21", Valid: true}, want: "2015-10-21"},
+		{text: pgtype.Text{String: " 2015-10-21 ", Valid: true}, want: "2015-10-21"},
+		{text: pgtype.Text{String: "2015-10-21 08:30:00", Valid: true}, want: "2015-10-21"},
+		{text: pgtype.Text{String: "", Valid: true}, want: ""},
+		{text: pgtype.Text{}, want: ""},
+		{text: pgtype.Text{String: "2015-10-21", Valid: false}, want: ""},
+		{text: pgtype.Text{String: "21 Oct", Valid: true}, wantErr: dbtypes.ErrInvalidDateFormat},
+		{text: pgtype.Text{String: "2015-02-30", Valid: true}, wantErr: dbtypes.ErrInvalidDateFormat},
+		{text: pgtype.Text{String: "infinity", Valid: true}, wantErr: dbtypes.ErrInfiniteDate},
+	}
+
+	for _, tt := range tests {
+		date := dbtypes.NewDateUTC(2000, time.January, 1)
+		err := date.ScanText(tt.text)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ScanText(%+v) error = %v, want %v", tt.text, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ScanText(%+v) returned error: %v", tt.text, err)
+		}
+		if date.String() != tt.want {
+			t.Errorf("ScanText(%+v) = %s, want %s", tt.text, date, tt.want)
+		}
+	}
+}
+
+func TestDateTextValue(t *testing.T) {
+	defer dbtypes.ZeroDateAsNull(false)
+
+	tests := []struct {
+		date       dbtypes.Date
+		zeroAsNull bool
+		want       pgtype.Text
+	}{
+		{date: dbtypes.NewDateUTC(2015, time.October, 21), want: pgtype.Text{String: "2015-10-21", Valid: true}},
+		{date: dbtypes.Date{}, want: pgtype.Text{String: "0001-01-01", Valid: true}},
+		{date: dbtypes.Date{}, zeroAsNull: true, want: pgtype.Text{}},
+	}
+
+	for _, tt := range tests {
+		dbtypes.ZeroDateAsNull(tt.zeroAsNull)
+		got, err := tt.date.TextValue()
+		if err != nil {
+			t.Fatalf("TextValue() returned error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("TextValue() of %s = %+v, want %+v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestDatePgxTextColumn(t *testing.T) {
+	m := pgtype.NewMap()
+	date := dbtypes.NewDateUTC(2015, time.October, 21)
+
+	buf, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, date, nil)
+	if err != nil || string(buf) != "2015-10-21" {
+		t.Fatalf("Encode(text) = %q, %v, want 2015-10-21", buf, err)
+	}
+
+	var got dbtypes.Date
+	if err := m.Scan(pgtype.TextOID, pgtype.TextFormatCode, buf, &got); err != nil || !got.Equal(date) {
+		t.Errorf("Scan(text %q) = %s, %v, want %s", buf, got, err, date)
+	}
+}
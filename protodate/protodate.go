@@ -0,0 +1,65 @@
+// Package protodate converts between dbtypes.Date and the protobuf
+// google.type.Date and google.protobuf.Timestamp messages.
+// It lives in its own package so that the core dbtypes package
+// does not depend on protobuf.
+package protodate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromDate returns d as a google.type.Date, or nil for the zero date.
+func FromDate(d dbtypes.Date) *date.Date {
+	if d.IsZero() {
+		return nil
+	}
+	return &date.Date{Year: int32(d.Year()), Month: int32(d.Month()), Day: int32(d.Day())}
+}
+
+// ToDate returns the date of pd at midnight UTC, or the zero date if pd is nil.
+// Partial dates with a zero year, month or day and out of range components
+// are rejected with an error wrapping dbtypes.ErrDateOutOfRange.
+func ToDate(pd *date.Date) (dbtypes.Date, error) {
+	if pd == nil {
+		return dbtypes.Date{}, nil
+	}
+
+	y, m, d := int(pd.GetYear()), time.Month(pd.GetMonth()), int(pd.GetDay())
+	if y < 1 || y > 9999 || m < time.January || m > time.December || d < 1 || d > daysIn(y, m) {
+		return dbtypes.Date{}, fmt.Errorf("protodate: invalid date %04d-%02d-%02d: %w", y, m, d, dbtypes.ErrDateOutOfRange)
+	}
+	return dbtypes.NewDateUTC(y, m, d), nil
+}
+
+// ToTimestamp returns the first instant of d in loc (UTC if loc is nil)
+// as a Timestamp, or nil for the zero date.
+func ToTimestamp(d dbtypes.Date, loc *time.Location) *timestamppb.Timestamp {
+	if d.IsZero() {
+		return nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return timestamppb.New(d.StartOfDayIn(loc))
+}
+
+// FromTimestamp returns the calendar date of ts in loc (UTC if loc is nil),
+// discarding the time of day, or the zero date if ts is nil.
+func FromTimestamp(ts *timestamppb.Timestamp, loc *time.Location) dbtypes.Date {
+	if ts == nil {
+		return dbtypes.Date{}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return dbtypes.DateFromTime(ts.AsTime().In(loc))
+}
+
+func daysIn(year int, month time.Month) int {
+	return dbtypes.NewDateUTC(year, month, 1).DaysInMonth()
+}
@@ -0,0 +1,109 @@
+package protodate_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/abiiranathan/dbtypes"
+	"github.com/abiiranathan/dbtypes/protodate"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDateRoundTrip(t *testing.T) {
+	for _, d := range []dbtypes.Date{
+		dbtypes.NewDateUTC(2015, time.October, 21),
+		dbtypes.NewDateUTC(2024, time.February, 29),
+		dbtypes.NewDateUTC(1, time.January, 2),
+	} {
+		pd := protodate.FromDate(d)
+		want := &date.Date{Year: int32(d.Year()), Month: int32(d.Month()), Day: int32(d.Day())}
+		if !proto.Equal(pd, want) {
+			t.Errorf("FromDate(%s) = %v, want %v", d, pd, want)
+		}
+
+		got, err := protodate.ToDate(pd)
+		if err != nil {
+			t.Fatalf("ToDate(%v) returned error: %v", pd, err)
+		}
+		if !got.Equal(d) {
+			t.Errorf("ToDate(FromDate(%s)) = %s", d, got)
+		}
+	}
+}
+
+func TestDateNil(t *testing.T) {
+	if got := protodate.FromDate(dbtypes.Date{}); got != nil {
+		t.Errorf("FromDate(zero) = %v, want nil", got)
+	}
+	if got, err := protodate.ToDate(nil); err != nil || !got.IsZero() {
+		t.Errorf("ToDate(nil) = %s, %v, want zero date", got, err)
+	}
+	if got := protodate.ToTimestamp(dbtypes.Date{}, nil); got != nil {
+		t.Errorf("ToTimestamp(zero) = %v, want nil", got)
+	}
+	if got := protodate.FromTimestamp(nil, nil); !got.IsZero() {
+		t.Errorf("FromTimestamp(nil) = %s, want zero date", got)
+	}
+}
+
+func TestToDateInvalid(t *testing.T) {
+	for _, pd := range []*date.Date{
+		{Year: 2015, Month: 2, Day: 30},
+		{Year: 2015, Month: 13, Day: 1},
+		{Year: 0, Month: 10, Day: 21},
+		{Year: 2015, Month: 10, Day: 0},
+		{Year: 2015, Month: 0, Day: 0},
+	} {
+		if _, err := protodate.ToDate(pd); !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+			t.Errorf("ToDate(%v) error = %v, want %v", pd, err, dbtypes.ErrDateOutOfRange)
+		}
+	}
+}
+
+func TestTimestampRoundTrip(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := dbtypes.NewDateUTC(2015, time.October, 21)
+
+	tests := []struct {
+		loc  *time.Location
+		want time.Time
+	}{
+		{loc: nil, want: time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)},
+		{loc: time.UTC, want: time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)},
+		{loc: newYork, want: time.Date(2015, 10, 21, 4, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		ts := protodate.ToTimestamp(d, tt.loc)
+		if !ts.AsTime().Equal(tt.want) {
+			t.Errorf("ToTimestamp(%s, %v) = %v, want %v", d, tt.loc, ts.AsTime(), tt.want)
+		}
+
+		if got := protodate.FromTimestamp(ts, tt.loc); !got.Equal(d) {
+			t.Errorf("FromTimestamp(ToTimestamp(%s, %v)) = %s", d, tt.loc, got)
+		}
+	}
+}
+
+func TestFromTimestampTruncates(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := timestamppb.New(time.Date(2015, 10, 22, 2, 30, 0, 0, time.UTC))
+	if got := protodate.FromTimestamp(ts, nil); got.String() != "2015-10-22" {
+		t.Errorf("FromTimestamp(%v, UTC) = %s, want 2015-10-22", ts.AsTime(), got)
+	}
+	if got := protodate.FromTimestamp(ts, newYork); got.String() != "2015-10-21" {
+		t.Errorf("FromTimestamp(%v, New York) = %s, want 2015-10-21", ts.AsTime(), got)
+	}
+}
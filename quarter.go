@@ -0,0 +1,220 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// quarterLayout describes the format accepted by ParseQuarter.
+const quarterLayout = "yyyy-Qq"
+
+// Quarter is a quarter of a calendar year, stored as "2006-Q1" like "2023-Q3".
+// The zero Quarter represents no quarter.
+type Quarter struct {
+	Year    int
+	Quarter int
+}
+
+// NewQuarter returns the given quarter of year. It returns an error wrapping
+// ErrDateOutOfRange if quarter is not between 1 and 4.
+// Use NewQuarterDate for the normalizing date equivalent.
+func NewQuarter(year, quarter int) (Quarter, error) {
+	if quarter < 1 || quarter > 4 {
+		return Quarter{}, fmt.Errorf("quarter %d of year %d: %w", quarter, year, ErrDateOutOfRange)
+	}
+	return Quarter{Year: year, Quarter: quarter}, nil
+}
+
+// QuarterOf returns the quarter in which the date occurs.
+// The zero date returns the zero Quarter.
+func (date Date) QuarterOf() Quarter {
+	if date.IsZero() {
+		return Quarter{}
+	}
+	return Quarter{Year: date.Year(), Quarter: date.Quarter()}
+}
+
+// ParseQuarter parses a quarter like "2023-Q3".
+// Errors are of type *ParseError.
+func ParseQuarter(s string) (Quarter, error) {
+	if strings.TrimSpace(s) == "" {
+		return Quarter{}, &ParseError{Input: s, Layout: quarterLayout, Err: ErrEmptyDate}
+	}
+
+	yearStr, quarterStr, ok := strings.Cut(s, "-Q")
+	if !ok || len(yearStr) < 4 || len(quarterStr) != 1 {
+		return Quarter{}, &ParseError{Input: s, Layout: quarterLayout, Err: ErrInvalidDateFormat}
+	}
+
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		return Quarter{}, &ParseError{Input: s, Layout: quarterLayout, Err: ErrInvalidDateFormat}
+	}
+
+	quarter, err := strconv.Atoi(quarterStr)
+	if err != nil {
+		return Quarter{}, &ParseError{Input: s, Layout: quarterLayout, Err: ErrInvalidDateFormat}
+	}
+
+	if quarter < 1 || quarter > 4 {
+		return Quarter{}, &ParseError{Input: s, Layout: quarterLayout, Err: ErrDateOutOfRange}
+	}
+	return Quarter{Year: year, Quarter: quarter}, nil
+}
+
+// IsZero reports whether q is the zero Quarter.
+func (q Quarter) IsZero() bool {
+	return q == Quarter{}
+}
+
+// Start returns the first day of the quarter in the default location
+// (see SetDefaultLocation). The zero Quarter returns the zero date.
+func (q Quarter) Start() Date {
+	if q.IsZero() {
+		return Date{}
+	}
+	return NewQuarterDate(q.Year, q.Quarter)
+}
+
+// End returns the last day of the quarter in the default location.
+// The zero Quarter returns the zero date.
+func (q Quarter) End() Date {
+	if q.IsZero() {
+		return Date{}
+	}
+	return q.Start().EndOfQuarter()
+}
+
+// Contains reports whether the date falls within the quarter.
+func (q Quarter) Contains(date Date) bool {
+	return !q.IsZero() && date.QuarterOf() == q
+}
+
+// Add returns the quarter n quarters after q, or before if n is negative.
+// The zero Quarter is returned unchanged.
+func (q Quarter) Add(n int) Quarter {
+	if q.IsZero() {
+		return q
+	}
+
+	index := q.index() + n
+	year, quarter := index/4, index%4
+	if quarter < 0 {
+		year, quarter = year-1, quarter+4
+	}
+	return Quarter{Year: year, Quarter: quarter + 1}
+}
+
+// Next returns the following quarter. The zero Quarter is returned unchanged.
+func (q Quarter) Next() Quarter {
+	return q.Add(1)
+}
+
+// Prev returns the preceding quarter. The zero Quarter is returned unchanged.
+func (q Quarter) Prev() Quarter {
+	return q.Add(-1)
+}
+
+// index returns the number of quarters since the first quarter of year 0.
+func (q Quarter) index() int {
+	return q.Year*4 + q.Quarter - 1
+}
+
+// Compare returns -1 if q is before other, +1 if it is after and 0 if they are equal.
+func (q Quarter) Compare(other Quarter) int {
+	a, b := q.index(), other.index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// String returns the quarter formatted like "2023-Q3",
+// or an empty string for the zero Quarter.
+func (q Quarter) String() string {
+	if q.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-Q%d", q.Year, q.Quarter)
+}
+
+// Scan implements the sql.Scanner interface for quarters stored as strings.
+// NULL and empty strings scan as the zero Quarter.
+func (q *Quarter) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*q = Quarter{}
+		return nil
+	case string:
+		return q.UnmarshalText([]byte(v))
+	case []byte:
+		return q.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into Quarter", value)
+}
+
+// Value implements the driver.Valuer interface, storing the quarter as a
+// string like "2023-Q3". The zero Quarter is stored as NULL.
+func (q Quarter) Value() (driver.Value, error) {
+	if q.IsZero() {
+		return nil, nil
+	}
+	return q.String(), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (q Quarter) GormDataType() string {
+	return "string"
+}
+
+// MarshalJSON marshals the quarter as a string like "2023-Q3",
+// or null for the zero Quarter.
+func (q Quarter) MarshalJSON() ([]byte, error) {
+	if q.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(q.String())
+}
+
+// UnmarshalJSON parses a quarter string. null and "" set the zero Quarter.
+func (q *Quarter) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*q = Quarter{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &ParseError{Input: string(data), Layout: quarterLayout, Err: ErrInvalidDateFormat}
+	}
+	return q.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The zero Quarter is empty text.
+func (q Quarter) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text sets the zero Quarter.
+func (q *Quarter) UnmarshalText(text []byte) error {
+	if strings.TrimSpace(string(text)) == "" {
+		*q = Quarter{}
+		return nil
+	}
+
+	parsed, err := ParseQuarter(string(text))
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
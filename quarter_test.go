@@ -0,0 +1,180 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestParseQuarter(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    dbtypes.Quarter
+		wantErr error
+	}{
+		{input: "2023-Q3", want: dbtypes.Quarter{Year: 2023, Quarter: 3}},
+		{input: "2024-Q1", want: dbtypes.Quarter{Year: 2024, Quarter: 1}},
+		{input: "2023-Q0", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-Q5", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-Q", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023Q3", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "23-Q3", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023-QX", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "", wantErr: dbtypes.ErrEmptyDate},
+	}
+
+	for _, tt := range tests {
+		got, err := dbtypes.ParseQuarter(tt.input)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseQuarter(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseQuarter(%q) returned error: %v", tt.input, err)
+		}
+		if got != tt.want || got.String() != tt.input {
+			t.Errorf("ParseQuarter(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	for _, q := range []int{0, 5} {
+		if _, err := dbtypes.NewQuarter(2023, q); !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+			t.Errorf("NewQuarter(2023, %d) error = %v, want ErrDateOutOfRange", q, err)
+		}
+	}
+}
+
+func TestQuarterStartEnd(t *testing.T) {
+	tests := []struct {
+		year, quarter int
+		start, end    string
+	}{
+		{year: 2024, quarter: 1, start: "2024-01-01", end: "2024-03-31"},
+		{year: 2023, quarter: 1, start: "2023-01-01", end: "2023-03-31"},
+		{year: 2023, quarter: 2, start: "2023-04-01", end: "2023-06-30"},
+		{year: 2023, quarter: 3, start: "2023-07-01", end: "2023-09-30"},
+		{year: 2023, quarter: 4, start: "2023-10-01", end: "2023-12-31"},
+	}
+
+	for _, tt := range tests {
+		q, err := dbtypes.NewQuarter(tt.year, tt.quarter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Start().String() != tt.start || q.End().String() != tt.end {
+			t.Errorf("%s = %s..%s, want %s..%s", q, q.Start(), q.End(), tt.start, tt.end)
+		}
+		if q.Start().QuarterOf() != q || q.End().QuarterOf() != q || q.Contains(q.End().AddDays(1)) {
+			t.Errorf("%s.QuarterOf() is inconsistent with Start and End", q)
+		}
+		if days := q.Start().DaysBetween(q.End()) + 1; tt.year == 2024 && tt.quarter == 1 && days != 91 {
+			t.Errorf("%s has %d days, want 91", q, days)
+		}
+	}
+
+	if q := (dbtypes.Date{}).QuarterOf(); !q.IsZero() || !q.Start().IsZero() {
+		t.Errorf("zero date QuarterOf() = %s, want zero", q)
+	}
+}
+
+func TestQuarterAdd(t *testing.T) {
+	q3 := dbtypes.Quarter{Year: 2023, Quarter: 3}
+
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 0, want: "2023-Q3"},
+		{n: 1, want: "2023-Q4"},
+		{n: 2, want: "2024-Q1"},
+		{n: -2, want: "2023-Q1"},
+		{n: -3, want: "2022-Q4"},
+		{n: -7, want: "2021-Q4"},
+		{n: 9, want: "2025-Q4"},
+	}
+
+	for _, tt := range tests {
+		if got := q3.Add(tt.n).String(); got != tt.want {
+			t.Errorf("%s.Add(%d) = %s, want %s", q3, tt.n, got, tt.want)
+		}
+	}
+
+	q4 := dbtypes.Quarter{Year: 2023, Quarter: 4}
+	if got := q4.Next().String(); got != "2024-Q1" {
+		t.Errorf("%s.Next() = %s, want 2024-Q1", q4, got)
+	}
+	if got := q4.Next().Prev(); got != q4 {
+		t.Errorf("%s.Next().Prev() = %s", q4, got)
+	}
+	if q3.Compare(q4) != -1 || q4.Compare(q3) != 1 || q3.Compare(q3) != 0 {
+		t.Errorf("Compare(%s, %s) is inconsistent", q3, q4)
+	}
+	if got := (dbtypes.Quarter{}).Next(); !got.IsZero() {
+		t.Errorf("zero Next() = %s, want zero", got)
+	}
+}
+
+func TestQuarterJSON(t *testing.T) {
+	type report struct {
+		Period dbtypes.Quarter `json:"period"`
+		Prior  dbtypes.Quarter `json:"prior"`
+	}
+
+	in := report{Period: dbtypes.NewDateUTC(2023, time.August, 15).QuarterOf()}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `{"period":"2023-Q3","prior":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got report
+	if err := json.Unmarshal(data, &got); err != nil || got != in {
+		t.Errorf("json.Unmarshal(%s) = %+v, %v, want %+v", data, got, err, in)
+	}
+
+	for _, input := range []string{`"2023-Q5"`, `3`, `"Q3 2023"`} {
+		var q dbtypes.Quarter
+		if err := json.Unmarshal([]byte(input), &q); err == nil {
+			t.Errorf("json.Unmarshal(%s) error = nil, want error", input)
+		}
+	}
+}
+
+func TestQuarterScanValue(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{value: "2023-Q3", want: "2023-Q3"},
+		{value: []byte("2024-Q1"), want: "2024-Q1"},
+		{value: nil, want: ""},
+		{value: "2023-Q0", wantErr: true},
+		{value: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := dbtypes.Quarter{Year: 2000, Quarter: 1}
+		err := got.Scan(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && got.String() != tt.want {
+			t.Errorf("Scan(%v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+
+	if v, err := (dbtypes.Quarter{Year: 2023, Quarter: 3}).Value(); err != nil || v != "2023-Q3" {
+		t.Errorf("Value() = %v, %v, want 2023-Q3", v, err)
+	}
+	if v, err := (dbtypes.Quarter{}).Value(); err != nil || v != nil {
+		t.Errorf("Value() of zero = %v, %v, want nil", v, err)
+	}
+}
@@ -0,0 +1,30 @@
+package dbtypes
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// RandomDate returns a uniformly distributed date between min and max inclusive,
+// at midnight UTC. The bounds are compared as calendar dates and may be given
+// in either order.
+func RandomDate(r *rand.Rand, min, max Date) Date {
+	lo, hi := min.civilDays(), max.civilDays()
+	if hi < lo {
+		lo, hi = hi, lo
+	}
+	return DateFromUnix((lo + r.Int63n(hi-lo+1)) * 86400)
+}
+
+// Bounds of the dates produced by Date.Generate.
+var (
+	generateMin = NewDateUTC(1900, 1, 1)
+	generateMax = NewDateUTC(2099, 12, 31)
+)
+
+// Generate implements the testing/quick.Generator interface.
+// It returns a random date between 1900-01-01 and 2099-12-31 at midnight UTC.
+// The size hint is ignored.
+func (Date) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(RandomDate(r, generateMin, generateMax))
+}
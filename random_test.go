@@ -0,0 +1,95 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+// isCanonical reports whether date is at midnight UTC.
+func isCanonical(date dbtypes.Date) bool {
+	t := time.Time(date)
+	return t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour))
+}
+
+func TestRandomDate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		name     string
+		min, max dbtypes.Date
+	}{
+		{name: "year", min: dbtypes.MustParseDate("2023-01-01"), max: dbtypes.MustParseDate("2023-12-31")},
+		{name: "leap february", min: dbtypes.MustParseDate("2024-02-27"), max: dbtypes.MustParseDate("2024-03-01")},
+		{name: "single day", min: dbtypes.MustParseDate("2024-02-29"), max: dbtypes.MustParseDate("2024-02-29")},
+		{name: "reversed", min: dbtypes.MustParseDate("2000-12-31"), max: dbtypes.MustParseDate("1999-01-01")},
+		{name: "before epoch", min: dbtypes.MustParseDate("1900-01-01"), max: dbtypes.MustParseDate("1969-12-31")},
+		{
+			name: "time of day ignored",
+			min:  dbtypes.Date(time.Date(2023, 5, 1, 23, 0, 0, 0, time.UTC)),
+			max:  dbtypes.Date(time.Date(2023, 5, 3, 1, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi := tt.min, tt.max
+			if hi.Before(lo) {
+				lo, hi = hi, lo
+			}
+
+			seen := make(map[string]bool)
+			for i := 0; i < 1000; i++ {
+				got := dbtypes.RandomDate(r, tt.min, tt.max)
+				if got.Before(lo) || got.After(hi) {
+					t.Fatalf("RandomDate() = %s, want within [%s, %s]", got, lo, hi)
+				}
+				if !isCanonical(got) {
+					t.Fatalf("RandomDate() = %v, want midnight UTC", time.Time(got))
+				}
+				seen[got.String()] = true
+			}
+
+			if want := lo.DaysBetween(hi) + 1; want <= 5 && len(seen) != want {
+				t.Errorf("RandomDate() produced %d distinct dates, want %d", len(seen), want)
+			}
+		})
+	}
+}
+
+func TestDateGenerate(t *testing.T) {
+	min, max := dbtypes.MustParseDate("1900-01-01"), dbtypes.MustParseDate("2099-12-31")
+
+	inBounds := func(date dbtypes.Date) bool {
+		return !date.Before(min) && !date.After(max) && isCanonical(date)
+	}
+	if err := quick.Check(inBounds, nil); err != nil {
+		t.Error(err)
+	}
+
+	roundTrips := func(date dbtypes.Date) bool {
+		parsed, err := dbtypes.ParseDate(date.String())
+		return err == nil && parsed.Equal(date)
+	}
+	if err := quick.Check(roundTrips, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMustParseDate(t *testing.T) {
+	if got := dbtypes.MustParseDate("2015-10-21"); got.String() != "2015-10-21" {
+		t.Errorf("MustParseDate() = %s, want 2015-10-21", got)
+	}
+
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+			t.Errorf("MustParseDate(\"2015-02-30\") panicked with %v, want %v", err, dbtypes.ErrDateOutOfRange)
+		}
+	}()
+	dbtypes.MustParseDate("2015-02-30")
+}
@@ -0,0 +1,176 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidJSON is returned by RawJSON.Validate for malformed JSON.
+var ErrInvalidJSON = errors.New("invalid JSON")
+
+// RawJSON is a JSON column kept as the exact bytes stored in the database,
+// e.g. to pass a jsonb column through to an HTTP response without decoding
+// it. Unlike JSON it preserves key order, whitespace and number formatting.
+// A nil or empty RawJSON is NULL.
+type RawJSON json.RawMessage
+
+// validateRawJSON controls whether RawJSON.Scan validates the scanned JSON.
+var validateRawJSON bool
+
+// ValidateRawJSON configures whether RawJSON.Scan checks that the scanned
+// bytes are well-formed JSON, returning an error wrapping ErrInvalidJSON
+// otherwise. It is disabled by default since databases like PostgreSQL
+// already validate json and jsonb columns.
+// This should be called once at program startup.
+func ValidateRawJSON(enable bool) {
+	validateRawJSON = enable
+}
+
+// Scan scans a value into RawJSON, implements sql.Scanner interface.
+// It accepts JSON text as []byte or string and NULL. The bytes are copied
+// since drivers may reuse their buffers after Scan returns.
+func (r *RawJSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*r = nil
+		return nil
+	case []byte:
+		if err := checkJSONSize(len(v)); err != nil {
+			return err
+		}
+		*r = append(RawJSON(nil), v...)
+	case string:
+		if err := checkJSONSize(len(v)); err != nil {
+			return err
+		}
+		*r = RawJSON(v)
+	default:
+		return &ScanTypeError{Value: value, Target: "RawJSON"}
+	}
+
+	if validateRawJSON {
+		return r.Validate()
+	}
+	return nil
+}
+
+// Value returns the JSON text, implements driver.Valuer interface.
+// Empty values are NULL.
+func (r RawJSON) Value() (driver.Value, error) {
+	if len(r) == 0 {
+		return nil, nil
+	}
+	return string(r), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (r RawJSON) GormDataType() string {
+	return "jsonb"
+}
+
+// Validate returns an error wrapping ErrInvalidJSON with the position
+// of the syntax error if r is not well-formed JSON. Empty values are valid.
+func (r RawJSON) Validate() error {
+	if len(r) == 0 || json.Valid(r) {
+		return nil
+	}
+
+	// Compact reports where the syntax error is, unlike Valid.
+	err := json.Compact(new(bytes.Buffer), r)
+	if err == nil {
+		err = errors.New("unexpected end of JSON input")
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+}
+
+// IsNull reports whether r is empty or the JSON literal null.
+func (r RawJSON) IsNull() bool {
+	trimmed := bytes.TrimSpace(r)
+	return len(trimmed) == 0 || string(trimmed) == "null"
+}
+
+// IsEmpty reports whether r is null (see IsNull), an empty object or
+// an empty array.
+func (r RawJSON) IsEmpty() bool {
+	if r.IsNull() {
+		return true
+	}
+
+	trimmed := bytes.TrimSpace(r)
+	if len(trimmed) < 2 {
+		return false
+	}
+	first, last := trimmed[0], trimmed[len(trimmed)-1]
+	if (first != '{' || last != '}') && (first != '[' || last != ']') {
+		return false
+	}
+	return len(bytes.TrimSpace(trimmed[1:len(trimmed)-1])) == 0
+}
+
+// Compact returns r without insignificant whitespace, keeping the order of
+// keys and the formatting of numbers. Empty values are null. It returns an
+// error wrapping ErrInvalidJSON if r is malformed.
+func (r RawJSON) Compact() ([]byte, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, r); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Indent returns r indented like json.Indent, keeping the order of keys
+// and the formatting of numbers. Empty values are null. It returns an
+// error wrapping ErrInvalidJSON if r is malformed.
+func (r RawJSON) Indent(prefix, indent string) ([]byte, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, bytes.TrimSpace(r), prefix, indent); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalJSON returns r as is, or null if it is empty.
+func (r RawJSON) MarshalJSON() ([]byte, error) {
+	if len(r) == 0 {
+		return []byte("null"), nil
+	}
+	return r, nil
+}
+
+// UnmarshalJSON sets r to a copy of data.
+func (r *RawJSON) UnmarshalJSON(data []byte) error {
+	if err := checkJSONSize(len(data)); err != nil {
+		return err
+	}
+	*r = append((*r)[:0], data...)
+	return nil
+}
+
+// GobEncode encodes the raw bytes using gob encoding.
+func (r RawJSON) GobEncode() ([]byte, error) {
+	return r, nil
+}
+
+// GobDecode sets r to a copy of the gob-encoded bytes.
+func (r *RawJSON) GobDecode(data []byte) error {
+	if err := checkJSONSize(len(data)); err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		*r = nil
+		return nil
+	}
+	*r = append(RawJSON(nil), data...)
+	return nil
+}
@@ -0,0 +1,238 @@
+package dbtypes_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestRawJSONScanCopiesBuffer(t *testing.T) {
+	buf := []byte(`{"b":1, "a":[1.50,2]}`)
+	want := string(buf)
+
+	var r dbtypes.RawJSON
+	if err := r.Scan(buf); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+
+	// Drivers reuse their buffers for the next row.
+	copy(buf, bytes.Repeat([]byte("x"), len(buf)))
+
+	if string(r) != want {
+		t.Errorf("Scan() = %s after the buffer was overwritten, want %s", r, want)
+	}
+}
+
+func TestRawJSONScan(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    string
+		wantNil bool
+	}{
+		{nil, "", true},
+		{`[3, 1, 2]`, `[3, 1, 2]`, false},
+		{[]byte(`{"z":1,"a":2}`), `{"z":1,"a":2}`, false},
+		{`not json`, `not json`, false},
+	}
+
+	for _, tt := range tests {
+		r := dbtypes.RawJSON(`"previous"`)
+		if err := r.Scan(tt.value); err != nil {
+			t.Errorf("Scan(%v) returned error: %v", tt.value, err)
+			continue
+		}
+		if string(r) != tt.want || (r == nil) != tt.wantNil {
+			t.Errorf("Scan(%v) = %q, want %q", tt.value, r, tt.want)
+		}
+	}
+
+	var r dbtypes.RawJSON
+	var typeErr *dbtypes.ScanTypeError
+	if err := r.Scan(42); !errors.As(err, &typeErr) {
+		t.Errorf("Scan(42) error = %v, want *ScanTypeError", err)
+	}
+}
+
+func TestRawJSONScanValidation(t *testing.T) {
+	dbtypes.ValidateRawJSON(true)
+	defer dbtypes.ValidateRawJSON(false)
+
+	tests := []struct {
+		value   interface{}
+		wantErr bool
+	}{
+		{nil, false},
+		{`{"a":1}`, false},
+		{[]byte(` [1, "two", null] `), false},
+		{`"text"`, false},
+		{`{"a":}`, true},
+		{[]byte(`{"a":1`), true},
+		{`not json`, true},
+		{`{"a":1} {"b":2}`, true},
+	}
+
+	for _, tt := range tests {
+		var r dbtypes.RawJSON
+		err := r.Scan(tt.value)
+		if tt.wantErr && !errors.Is(err, dbtypes.ErrInvalidJSON) {
+			t.Errorf("Scan(%v) error = %v, want ErrInvalidJSON", tt.value, err)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("Scan(%v) returned error: %v", tt.value, err)
+		}
+	}
+}
+
+func TestRawJSONValue(t *testing.T) {
+	tests := []struct {
+		r    dbtypes.RawJSON
+		want interface{}
+	}{
+		{nil, nil},
+		{dbtypes.RawJSON{}, nil},
+		{dbtypes.RawJSON(`null`), "null"},
+		{dbtypes.RawJSON(`{"z": 1.50, "a": 2}`), `{"z": 1.50, "a": 2}`},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.r.Value()
+		if err != nil {
+			t.Errorf("Value() returned error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("RawJSON(%q).Value() = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestRawJSONIsNullIsEmpty(t *testing.T) {
+	tests := []struct {
+		r               string
+		isNull, isEmpty bool
+	}{
+		{"", true, true},
+		{" null ", true, true},
+		{"{}", false, true},
+		{"[ \n ]", false, true},
+		{`""`, false, false},
+		{"0", false, false},
+		{`{"a":null}`, false, false},
+		{"[null]", false, false},
+		{"{]", false, false},
+	}
+
+	for _, tt := range tests {
+		r := dbtypes.RawJSON(tt.r)
+		if got := r.IsNull(); got != tt.isNull {
+			t.Errorf("RawJSON(%q).IsNull() = %v, want %v", tt.r, got, tt.isNull)
+		}
+		if got := r.IsEmpty(); got != tt.isEmpty {
+			t.Errorf("RawJSON(%q).IsEmpty() = %v, want %v", tt.r, got, tt.isEmpty)
+		}
+	}
+}
+
+func TestRawJSONMarshalJSON(t *testing.T) {
+	type response struct {
+		Data  dbtypes.RawJSON `json:"data"`
+		Empty dbtypes.RawJSON `json:"empty"`
+	}
+
+	data, err := json.Marshal(response{Data: dbtypes.RawJSON(`{"z":1,"a":1.50}`)})
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `{"data":{"z":1,"a":1.50},"empty":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got response
+	if err := json.Unmarshal([]byte(`{"data":{"z":1,"a":1.50},"empty":null}`), &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if string(got.Data) != `{"z":1,"a":1.50}` {
+		t.Errorf("json.Unmarshal() Data = %s", got.Data)
+	}
+	if string(got.Empty) != "null" {
+		t.Errorf("json.Unmarshal() Empty = %q, want null", got.Empty)
+	}
+}
+
+func TestRawJSONGob(t *testing.T) {
+	for _, r := range []dbtypes.RawJSON{nil, dbtypes.RawJSON(`{"z":1,"a":[true]}`)} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(r); err != nil {
+			t.Fatalf("gob Encode(%q) returned error: %v", r, err)
+		}
+
+		var got dbtypes.RawJSON
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("gob Decode(%q) returned error: %v", r, err)
+		}
+		if !bytes.Equal(got, r) {
+			t.Errorf("gob round trip = %q, want %q", got, r)
+		}
+	}
+}
+
+func TestRawJSONDatabase(t *testing.T) {
+	db := openFakeDB(t)
+
+	raw := dbtypes.RawJSON(`{"z": 1, "a": [2.50]}`)
+	if _, err := db.Exec("INSERT", raw, dbtypes.RawJSON(nil)); err != nil {
+		t.Fatalf("Exec() returned error: %v", err)
+	}
+
+	var got, null dbtypes.RawJSON
+	if err := db.QueryRow("SELECT").Scan(&got, &null); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if string(got) != string(raw) {
+		t.Errorf("Scan() = %s, want %s", got, raw)
+	}
+	if null != nil {
+		t.Errorf("Scan(NULL) = %q, want nil", null)
+	}
+}
+
+func TestRawJSONFormatting(t *testing.T) {
+	r := dbtypes.RawJSON(" {\"zeta\": 1.50,\n \"alpha\": [ 1e3, {\"b\": true, \"a\": null} ]} \n")
+
+	got, err := r.Compact()
+	if err != nil {
+		t.Fatalf("Compact() returned error: %v", err)
+	}
+	if want := `{"zeta":1.50,"alpha":[1e3,{"b":true,"a":null}]}`; string(got) != want {
+		t.Errorf("Compact() = %s, want %s", got, want)
+	}
+
+	got, err = r.Indent("", "\t")
+	if err != nil {
+		t.Fatalf("Indent() returned error: %v", err)
+	}
+	want := "{\n\t\"zeta\": 1.50,\n\t\"alpha\": [\n\t\t1e3,\n\t\t{\n\t\t\t\"b\": true,\n\t\t\t\"a\": null\n\t\t}\n\t]\n}"
+	if string(got) != want {
+		t.Errorf("Indent() = %q, want %q", got, want)
+	}
+
+	for _, empty := range []dbtypes.RawJSON{nil, {}} {
+		if got, err := empty.Compact(); err != nil || string(got) != "null" {
+			t.Errorf("empty Compact() = %s, %v, want null", got, err)
+		}
+		if got, err := empty.Indent("", "  "); err != nil || string(got) != "null" {
+			t.Errorf("empty Indent() = %s, %v, want null", got, err)
+		}
+	}
+
+	bad := dbtypes.RawJSON(`{"a":`)
+	if _, err := bad.Compact(); !errors.Is(err, dbtypes.ErrInvalidJSON) {
+		t.Errorf("Compact() error = %v, want ErrInvalidJSON", err)
+	}
+	if _, err := bad.Indent("", "  "); !errors.Is(err, dbtypes.ErrInvalidJSON) {
+		t.Errorf("Indent() error = %v, want ErrInvalidJSON", err)
+	}
+}
@@ -0,0 +1,176 @@
+package dbtypes
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Frequency is the period of a Recurrence.
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// maxEmptyPeriods bounds the search of Recurrence.Next for rules
+// that produce no further occurrences.
+const maxEmptyPeriods = 1000
+
+// Recurrence is a simple recurrence rule, a small subset of the iCalendar RRULE.
+//
+// Occurrences start on Start and repeat every Interval periods of Frequency.
+// Within each period, the occurrences are:
+//
+//   - Daily: the day itself, kept only if it matches ByWeekday and ByMonthDay when set.
+//   - Weekly: the days of the week (starting on the package first day of the
+//     week, see SetFirstDayOfWeek) in ByWeekday, or Start's weekday.
+//   - Monthly: the days of the month in ByMonthDay and/or ByWeekday,
+//     or Start's day of the month if neither is set.
+//   - Yearly: as Monthly, in Start's month.
+//
+// Days of the month past the end of a short month are clamped to its last day,
+// so "monthly on the 31st" falls on Feb 28, unless SkipShortMonths is set.
+// Occurrences before Start are never produced.
+type Recurrence struct {
+	Start           Date
+	Frequency       Frequency
+	Interval        int            // Every Interval periods; values below 1 mean 1
+	ByWeekday       []time.Weekday // Optional weekdays to occur on
+	ByMonthDay      []int          // Optional days of the month (1-31) to occur on
+	SkipShortMonths bool           // Skip months that are too short instead of clamping
+}
+
+// Occurrences returns the occurrences between from and to, both inclusive.
+func (r Recurrence) Occurrences(from, to Date) []Date {
+	var dates []Date
+	for date := range r.all() {
+		if date.After(to) {
+			break
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// Next returns the first occurrence after the given date,
+// or the zero Date if there is none.
+func (r Recurrence) Next(after Date) Date {
+	for date := range r.all() {
+		if date.After(after) {
+			return date
+		}
+	}
+	return Date{}
+}
+
+// all returns an iterator over every occurrence in order. It stops after
+// maxEmptyPeriods consecutive periods without occurrences.
+func (r Recurrence) all() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if r.Start.IsZero() || r.Frequency < Daily || r.Frequency > Yearly {
+			return
+		}
+
+		interval := max(r.Interval, 1)
+		empty := 0
+		for k := 0; empty < maxEmptyPeriods; k += interval {
+			found := false
+			for _, date := range r.period(k) {
+				if date.Before(r.Start) {
+					continue
+				}
+				found = true
+				if !yield(date) {
+					return
+				}
+			}
+
+			if found {
+				empty = 0
+			} else {
+				empty++
+			}
+		}
+	}
+}
+
+// period returns the candidate occurrences, in order, of the k-th period after Start.
+func (r Recurrence) period(k int) []Date {
+	loc := time.Time(r.Start).Location()
+	year, month, day := r.Start.civil()
+
+	switch r.Frequency {
+	case Daily:
+		date := r.Start.AddDays(k)
+		_, _, d := date.civil()
+		if r.matchesWeekday(date.Weekday()) && (len(r.ByMonthDay) == 0 || slices.Contains(r.ByMonthDay, d)) {
+			return []Date{date}
+		}
+		return nil
+	case Weekly:
+		weekdays := r.ByWeekday
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{r.Start.Weekday()}
+		}
+
+		var dates []Date
+		start := r.Start.WeekStart().AddDays(7 * k)
+		for i := 0; i < 7; i++ {
+			if date := start.AddDays(i); slices.Contains(weekdays, date.Weekday()) {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	case Monthly:
+		y, m, _ := addMonthsClamped(year, month, 1, k)
+		return r.monthDays(y, m, day, loc)
+	default:
+		return r.monthDays(year+k, month, day, loc)
+	}
+}
+
+// monthDays returns the occurrences in the given month, where startDay
+// is Start's day of the month.
+func (r Recurrence) monthDays(year int, month time.Month, startDay int, loc *time.Location) []Date {
+	monthDays := r.ByMonthDay
+	if len(monthDays) == 0 && len(r.ByWeekday) == 0 {
+		monthDays = []int{startDay}
+	}
+
+	n := daysInMonth(year, month)
+	var days [32]bool
+	for _, d := range monthDays {
+		if d > n {
+			if r.SkipShortMonths {
+				continue
+			}
+			d = n
+		}
+		if d >= 1 {
+			days[d] = true
+		}
+	}
+
+	var dates []Date
+	for d := 1; d <= n; d++ {
+		if len(monthDays) > 0 && !days[d] {
+			continue
+		}
+
+		date := NewDateIn(year, month, d, loc)
+		if r.matchesWeekday(date.Weekday()) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+func (r Recurrence) matchesWeekday(day time.Weekday) bool {
+	return len(r.ByWeekday) == 0 || slices.Contains(r.ByWeekday, day)
+}
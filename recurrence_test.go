@@ -0,0 +1,193 @@
+package dbtypes_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     dbtypes.Recurrence
+		from, to string
+		want     []string
+	}{
+		{
+			name: "daily",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2023-01-30"), Frequency: dbtypes.Daily},
+			from: "2023-01-01", to: "2023-02-02",
+			want: []string{"2023-01-30", "2023-01-31", "2023-02-01", "2023-02-02"},
+		},
+		{
+			name: "every third day",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2023-01-30"), Frequency: dbtypes.Daily, Interval: 3},
+			from: "2023-02-01", to: "2023-02-10",
+			want: []string{"2023-02-02", "2023-02-05", "2023-02-08"},
+		},
+		{
+			name: "daily on weekdays",
+			rule: dbtypes.Recurrence{
+				Start:     dbtypes.MustParseDate("2023-10-05"),
+				Frequency: dbtypes.Daily,
+				ByWeekday: []time.Weekday{time.Monday, time.Friday},
+			},
+			from: "2023-10-01", to: "2023-10-16",
+			want: []string{"2023-10-06", "2023-10-09", "2023-10-13", "2023-10-16"},
+		},
+		{
+			name: "weekly on start weekday",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2023-10-04"), Frequency: dbtypes.Weekly},
+			from: "2023-10-01", to: "2023-10-25",
+			want: []string{"2023-10-04", "2023-10-11", "2023-10-18", "2023-10-25"},
+		},
+		{
+			name: "every 2 weeks on Monday",
+			rule: dbtypes.Recurrence{
+				Start:     dbtypes.MustParseDate("2023-10-02"),
+				Frequency: dbtypes.Weekly,
+				Interval:  2,
+				ByWeekday: []time.Weekday{time.Monday},
+			},
+			from: "2023-10-01", to: "2023-11-15",
+			want: []string{"2023-10-02", "2023-10-16", "2023-10-30", "2023-11-13"},
+		},
+		{
+			name: "weekly on several days starting midweek",
+			rule: dbtypes.Recurrence{
+				Start:     dbtypes.MustParseDate("2023-10-04"),
+				Frequency: dbtypes.Weekly,
+				ByWeekday: []time.Weekday{time.Friday, time.Monday},
+			},
+			from: "2023-10-01", to: "2023-10-16",
+			want: []string{"2023-10-06", "2023-10-09", "2023-10-13", "2023-10-16"},
+		},
+		{
+			name: "monthly on the 15th",
+			rule: dbtypes.Recurrence{
+				Start:      dbtypes.MustParseDate("2023-01-20"),
+				Frequency:  dbtypes.Monthly,
+				ByMonthDay: []int{15},
+			},
+			from: "2023-01-01", to: "2023-04-30",
+			want: []string{"2023-02-15", "2023-03-15", "2023-04-15"},
+		},
+		{
+			name: "quarterly on start day",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2023-01-10"), Frequency: dbtypes.Monthly, Interval: 3},
+			from: "2023-01-01", to: "2023-12-31",
+			want: []string{"2023-01-10", "2023-04-10", "2023-07-10", "2023-10-10"},
+		},
+		{
+			name: "monthly on the 31st clamped",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2024-01-31"), Frequency: dbtypes.Monthly},
+			from: "2024-01-01", to: "2024-06-30",
+			want: []string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31", "2024-06-30"},
+		},
+		{
+			name: "monthly on the 31st skipped",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2024-01-31"), Frequency: dbtypes.Monthly, SkipShortMonths: true},
+			from: "2024-01-01", to: "2024-06-30",
+			want: []string{"2024-01-31", "2024-03-31", "2024-05-31"},
+		},
+		{
+			name: "monthly on 30th and 31st clamped once",
+			rule: dbtypes.Recurrence{
+				Start:      dbtypes.MustParseDate("2023-01-01"),
+				Frequency:  dbtypes.Monthly,
+				ByMonthDay: []int{31, 30},
+			},
+			from: "2023-01-01", to: "2023-03-31",
+			want: []string{"2023-01-30", "2023-01-31", "2023-02-28", "2023-03-30", "2023-03-31"},
+		},
+		{
+			name: "monthly on Fridays",
+			rule: dbtypes.Recurrence{
+				Start:     dbtypes.MustParseDate("2023-10-01"),
+				Frequency: dbtypes.Monthly,
+				Interval:  2,
+				ByWeekday: []time.Weekday{time.Friday},
+			},
+			from: "2023-10-01", to: "2023-12-31",
+			want: []string{"2023-10-06", "2023-10-13", "2023-10-20", "2023-10-27", "2023-12-01", "2023-12-08", "2023-12-15", "2023-12-22", "2023-12-29"},
+		},
+		{
+			name: "monthly on Friday the 13th",
+			rule: dbtypes.Recurrence{
+				Start:      dbtypes.MustParseDate("2023-01-01"),
+				Frequency:  dbtypes.Monthly,
+				ByWeekday:  []time.Weekday{time.Friday},
+				ByMonthDay: []int{13},
+			},
+			from: "2023-01-01", to: "2024-12-31",
+			want: []string{"2023-01-13", "2023-10-13", "2024-09-13", "2024-12-13"},
+		},
+		{
+			name: "yearly",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2020-06-15"), Frequency: dbtypes.Yearly},
+			from: "2021-01-01", to: "2023-12-31",
+			want: []string{"2021-06-15", "2022-06-15", "2023-06-15"},
+		},
+		{
+			name: "yearly on leap day clamped",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2020-02-29"), Frequency: dbtypes.Yearly},
+			from: "2020-01-01", to: "2024-12-31",
+			want: []string{"2020-02-29", "2021-02-28", "2022-02-28", "2023-02-28", "2024-02-29"},
+		},
+		{
+			name: "yearly on leap day skipped",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2020-02-29"), Frequency: dbtypes.Yearly, SkipShortMonths: true},
+			from: "2020-01-01", to: "2028-12-31",
+			want: []string{"2020-02-29", "2024-02-29", "2028-02-29"},
+		},
+		{
+			name: "range before start",
+			rule: dbtypes.Recurrence{Start: dbtypes.MustParseDate("2023-01-01"), Frequency: dbtypes.Daily},
+			from: "2022-01-01", to: "2022-12-31",
+			want: []string{},
+		},
+		{
+			name: "zero start",
+			rule: dbtypes.Recurrence{Frequency: dbtypes.Daily},
+			from: "2022-01-01", to: "2022-12-31",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Occurrences(dbtypes.MustParseDate(tt.from), dbtypes.MustParseDate(tt.to))
+			if !slices.Equal(dateStrings(got), tt.want) {
+				t.Errorf("Occurrences(%s, %s) = %v, want %v", tt.from, tt.to, dateStrings(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	monthly := dbtypes.Recurrence{Start: dbtypes.MustParseDate("2024-01-31"), Frequency: dbtypes.Monthly}
+
+	tests := []struct {
+		rule  dbtypes.Recurrence
+		after string
+		want  string
+	}{
+		{rule: monthly, after: "2023-12-31", want: "2024-01-31"},
+		{rule: monthly, after: "2024-01-31", want: "2024-02-29"},
+		{rule: monthly, after: "2024-02-29", want: "2024-03-31"},
+		{rule: monthly, after: "2030-11-30", want: "2030-12-31"},
+		{
+			rule:  dbtypes.Recurrence{Start: dbtypes.MustParseDate("2023-01-01"), Frequency: dbtypes.Monthly, ByMonthDay: []int{32}, SkipShortMonths: true},
+			after: "2023-01-01",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rule.Next(dbtypes.MustParseDate(tt.after)); got.String() != tt.want {
+			t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+		}
+	}
+}
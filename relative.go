@@ -0,0 +1,86 @@
+package dbtypes
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidRelativeDate is returned by ParseRelativeDate for malformed expressions.
+var ErrInvalidRelativeDate = errors.New("invalid relative date expression")
+
+// relativeLayout is the ParseError layout reported by ParseRelativeDate.
+const relativeLayout = "relative"
+
+// ParseRelativeDate evaluates a relative date expression against ref,
+// or against Today() if ref is the zero date.
+//
+// An expression is an optional keyword followed by zero or more signed offsets:
+//
+//	expr    = [keyword] {offset}
+//	keyword = "today" | "tomorrow" | "yesterday"
+//	offset  = ("+" | "-") digits unit
+//	unit    = "d" (days) | "w" (weeks) | "m" (months) | "y" (years)
+//
+// For example "+3d", "-2w", "+1m-2d" and "tomorrow +1w". Matching is
+// case-insensitive and whitespace between terms is ignored. Offsets are summed
+// and applied with a single AddDate call, so month and year offsets normalize
+// like AddDate (2023-01-31 +1m is 2023-03-03).
+// Errors are of type *ParseError wrapping ErrEmptyDate or ErrInvalidRelativeDate.
+func ParseRelativeDate(expr string, ref Date) (Date, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(expr), ""))
+	if s == "" {
+		return Date{}, &ParseError{Input: expr, Layout: relativeLayout, Err: ErrEmptyDate}
+	}
+
+	if ref.IsZero() {
+		ref = Today()
+	}
+
+	var years, months, days int
+	for _, keyword := range []struct {
+		name string
+		days int
+	}{{"today", 0}, {"tomorrow", 1}, {"yesterday", -1}} {
+		if rest, ok := strings.CutPrefix(s, keyword.name); ok {
+			s, days = rest, keyword.days
+			break
+		}
+	}
+
+	for s != "" {
+		if s[0] != '+' && s[0] != '-' {
+			return Date{}, &ParseError{Input: expr, Layout: relativeLayout, Err: ErrInvalidRelativeDate}
+		}
+
+		end := 1
+		for end < len(s) && unicode.IsDigit(rune(s[end])) {
+			end++
+		}
+		if end == 1 || end == len(s) {
+			return Date{}, &ParseError{Input: expr, Layout: relativeLayout, Err: ErrInvalidRelativeDate}
+		}
+
+		n, err := strconv.Atoi(s[:end])
+		if err != nil {
+			return Date{}, &ParseError{Input: expr, Layout: relativeLayout, Err: ErrInvalidRelativeDate}
+		}
+
+		switch s[end] {
+		case 'd':
+			days += n
+		case 'w':
+			days += 7 * n
+		case 'm':
+			months += n
+		case 'y':
+			years += n
+		default:
+			return Date{}, &ParseError{Input: expr, Layout: relativeLayout, Err: ErrInvalidRelativeDate}
+		}
+		s = s[end+1:]
+	}
+
+	return ref.AddDate(years, months, days), nil
+}
@@ -0,0 +1,70 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestParseRelativeDate(t *testing.T) {
+	ref := dbtypes.NewDate(2023, time.January, 31)
+
+	tests := []struct {
+		expr    string
+		want    string
+		wantErr error
+	}{
+		{expr: "today", want: "2023-01-31"},
+		{expr: "Tomorrow", want: "2023-02-01"},
+		{expr: "  YESTERDAY ", want: "2023-01-30"},
+		{expr: "+3d", want: "2023-02-03"},
+		{expr: "-2w", want: "2023-01-17"},
+		{expr: "+1m", want: "2023-03-03"},
+		{expr: "-1y", want: "2022-01-31"},
+		{expr: "+1m-2d", want: "2023-03-01"},
+		{expr: "+1M -2D", want: "2023-03-01"},
+		{expr: "tomorrow +1w", want: "2023-02-08"},
+		{expr: "+0d", want: "2023-01-31"},
+		{expr: "", wantErr: dbtypes.ErrEmptyDate},
+		{expr: "3d", wantErr: dbtypes.ErrInvalidRelativeDate},
+		{expr: "+3", wantErr: dbtypes.ErrInvalidRelativeDate},
+		{expr: "+d", wantErr: dbtypes.ErrInvalidRelativeDate},
+		{expr: "+3h", wantErr: dbtypes.ErrInvalidRelativeDate},
+		{expr: "next week", wantErr: dbtypes.ErrInvalidRelativeDate},
+		{expr: "today today", wantErr: dbtypes.ErrInvalidRelativeDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := dbtypes.ParseRelativeDate(tt.expr, ref)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseRelativeDate(%q) error = %v, want %v", tt.expr, err, tt.wantErr)
+				}
+				var parseErr *dbtypes.ParseError
+				if !errors.As(err, &parseErr) || parseErr.Input != tt.expr {
+					t.Errorf("ParseRelativeDate(%q) error = %#v, want *ParseError with Input %q", tt.expr, err, tt.expr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRelativeDate(%q) returned error: %v", tt.expr, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseRelativeDate(%q) = %s, want %s", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDateDefaultsToToday(t *testing.T) {
+	got, err := dbtypes.ParseRelativeDate("+1d", dbtypes.Date{})
+	if err != nil {
+		t.Fatalf("ParseRelativeDate() returned error: %v", err)
+	}
+	if want := dbtypes.Tomorrow(); !got.Equal(want) {
+		t.Errorf("ParseRelativeDate(\"+1d\", zero) = %s, want %s", got, want)
+	}
+}
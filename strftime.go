@@ -0,0 +1,184 @@
+package dbtypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Strftime formats the date using C/Python strftime-style verbs:
+//
+//	%Y  year with century (2015)        %y  year without century (15)
+//	%m  month, zero-padded (01-12)      %d  day of month, zero-padded (01-31)
+//	%e  day of month, space-padded      %j  day of year, zero-padded (001-366)
+//	%a  abbreviated weekday (Wed)       %A  full weekday (Wednesday)
+//	%b  abbreviated month (Oct)         %B  full month (October)
+//	%h  same as %b                      %F  same as %Y-%m-%d
+//	%u  ISO weekday (1-7, Monday is 1)  %w  weekday (0-6, Sunday is 0)
+//	%U  week of year, Sunday first (00-53)
+//	%V  ISO 8601 week number (01-53)
+//	%%  a literal percent sign
+//
+// Unknown verbs are written literally, including the percent sign,
+// e.g. "%Q" is output as "%Q". Zero dates return an empty string.
+func (date Date) Strftime(format string) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	t := time.Time(date)
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+
+		i++
+		switch format[i] {
+		case 'Y':
+			b.WriteString(t.Format("2006"))
+		case 'y':
+			b.WriteString(t.Format("06"))
+		case 'm':
+			b.WriteString(t.Format("01"))
+		case 'd':
+			b.WriteString(t.Format("02"))
+		case 'e':
+			b.WriteString(t.Format("_2"))
+		case 'j':
+			b.WriteString(t.Format("002"))
+		case 'a':
+			b.WriteString(t.Format("Mon"))
+		case 'A':
+			b.WriteString(t.Format("Monday"))
+		case 'b', 'h':
+			b.WriteString(t.Format("Jan"))
+		case 'B':
+			b.WriteString(t.Format("January"))
+		case 'F':
+			b.WriteString(t.Format(DateLayout))
+		case 'u':
+			b.WriteString(strconv.Itoa((int(t.Weekday())+6)%7 + 1))
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'U':
+			fmt.Fprintf(&b, "%02d", (t.YearDay()+6-int(t.Weekday()))/7)
+		case 'V':
+			_, week := t.ISOWeek()
+			fmt.Fprintf(&b, "%02d", week)
+		case '%':
+			b.WriteByte('%')
+		default:
+			b.WriteByte('%')
+			b.WriteByte(format[i])
+		}
+	}
+	return b.String()
+}
+
+// strftimeLayouts maps the parseable strftime verbs to Go layout verbs.
+var strftimeLayouts = map[byte]string{
+	'Y': "2006",
+	'y': "06",
+	'm': "01",
+	'd': "02",
+	'e': "_2",
+	'j': "002",
+	'a': "Mon",
+	'A': "Monday",
+	'b': "Jan",
+	'h': "Jan",
+	'B': "January",
+	'F': DateLayout,
+}
+
+// ParseStrftime parses value using a strftime-style format (see Date.Strftime)
+// and returns the date at midnight UTC.
+// The week-based verbs %U, %V, %u and %w cannot be parsed and result in an error.
+// Text outside verbs, including unknown verbs, must match literally.
+func ParseStrftime(format, value string) (Date, error) {
+	if strings.TrimSpace(value) == "" {
+		return Date{}, &ParseError{Input: value, Layout: format, Err: ErrEmptyDate}
+	}
+	invalid := func(err error) (Date, error) {
+		return Date{}, &ParseError{Input: value, Layout: format, Err: err}
+	}
+
+	// Literal text is matched here; only the text of each verb is passed to
+	// time.Parse, separated by "|", so that literals like "2" or "Jan" are
+	// not read as Go layout verbs.
+	var layout, fields []string
+	rest := value
+	for i := 0; i < len(format); i++ {
+		literal := format[i : i+1]
+		if format[i] == '%' && i+1 < len(format) {
+			i++
+			verb := format[i]
+			switch goLayout, ok := strftimeLayouts[verb]; {
+			case verb == 'U' || verb == 'V' || verb == 'u' || verb == 'w':
+				return invalid(fmt.Errorf("%w: verb %%%c cannot be parsed", ErrInvalidDateFormat, verb))
+			case verb == '%':
+				literal = "%"
+			case ok:
+				n := strftimeFieldLen(verb, rest)
+				layout = append(layout, goLayout)
+				fields = append(fields, rest[:n])
+				rest = rest[n:]
+				continue
+			default:
+				literal = format[i-1 : i+1]
+			}
+		}
+
+		if !strings.HasPrefix(rest, literal) {
+			return invalid(ErrInvalidDateFormat)
+		}
+		rest = rest[len(literal):]
+	}
+	if rest != "" {
+		return invalid(ErrInvalidDateFormat)
+	}
+
+	goLayout := strings.Join(layout, "|")
+	t, err := time.Parse(goLayout, strings.Join(fields, "|"))
+	if err != nil {
+		return invalid(parseErrorCause(err))
+	}
+
+	date, ok := parsedDate(t, goLayout)
+	if !ok {
+		return invalid(ErrDateOutOfRange)
+	}
+	return date, nil
+}
+
+// strftimeFieldLen returns the length of the text of verb at the start of
+// value, as time.Parse would read it. The text is validated by time.Parse.
+func strftimeFieldLen(verb byte, value string) int {
+	n := 0
+	switch verb {
+	case 'Y':
+		n = 4
+	case 'y', 'm', 'd':
+		n = 2
+	case 'j', 'a', 'b', 'h':
+		n = 3
+	case 'F':
+		n = len(DateLayout)
+	case 'e':
+		if strings.HasPrefix(value, " ") {
+			n = 1
+		}
+		for end := n + 2; n < len(value) && n < end && value[n] >= '0' && value[n] <= '9'; n++ {
+		}
+		return n
+	case 'A', 'B':
+		for n < len(value) && (value[n]|0x20 >= 'a' && value[n]|0x20 <= 'z') {
+			n++
+		}
+		return n
+	}
+	return min(n, len(value))
+}
@@ -0,0 +1,137 @@
+package dbtypes_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestDateStrftime(t *testing.T) {
+	date := dbtypes.NewDate(2015, time.October, 5) // a Monday
+
+	tests := []struct {
+		format   string
+		goLayout string
+		want     string
+	}{
+		{format: "%Y-%m-%d", goLayout: "2006-01-02", want: "2015-10-05"},
+		{format: "%F", goLayout: "2006-01-02", want: "2015-10-05"},
+		{format: "%d/%m/%y", goLayout: "02/01/06", want: "05/10/15"},
+		{format: "%e %B %Y", goLayout: "_2 January 2006", want: " 5 October 2015"},
+		{format: "%a, %d %b %Y", goLayout: "Mon, 02 Jan 2006", want: "Mon, 05 Oct 2015"},
+		{format: "%A %h", goLayout: "Monday Jan", want: "Monday Oct"},
+		{format: "day %j", goLayout: "day 002", want: "day 278"},
+		{format: "%% %Y", goLayout: "% 2006", want: "% 2015"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got := date.Strftime(tt.format)
+			if got != tt.want {
+				t.Errorf("Strftime(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+			if goFormatted := date.Format(tt.goLayout); got != goFormatted {
+				t.Errorf("Strftime(%q) = %q, Format(%q) = %q", tt.format, got, tt.goLayout, goFormatted)
+			}
+		})
+	}
+}
+
+func TestDateStrftimeWeekVerbs(t *testing.T) {
+	tests := []struct {
+		date   dbtypes.Date
+		format string
+		want   string
+	}{
+		{date: dbtypes.NewDate(2023, time.January, 1), format: "%U %V %u %w", want: "01 52 7 0"},
+		{date: dbtypes.NewDate(2022, time.January, 1), format: "%U %V %u %w", want: "00 52 6 6"},
+		{date: dbtypes.NewDate(2023, time.October, 18), format: "%U %V %u %w", want: "42 42 3 3"},
+		{date: dbtypes.NewDate(2024, time.December, 31), format: "%U %V", want: "52 01"},
+		{date: dbtypes.NewDate(2023, time.October, 18), format: "%Q %", want: "%Q %"},
+		{date: dbtypes.Date{}, format: "%Y", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.date.Strftime(tt.format); got != tt.want {
+			t.Errorf("%s.Strftime(%q) = %q, want %q", tt.date, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestParseStrftime(t *testing.T) {
+	tests := []struct {
+		format  string
+		value   string
+		want    string
+		wantErr error
+	}{
+		{format: "%Y-%m-%d", value: "2015-10-21", want: "2015-10-21"},
+		{format: "%d/%m/%Y", value: "21/10/2015", want: "2015-10-21"},
+		{format: "%e %B %Y", value: " 5 October 2015", want: "2015-10-05"},
+		{format: "%a, %d %b %y", value: "Wed, 21 Oct 15", want: "2015-10-21"},
+		{format: "%Y day %j", value: "2024 day 060", want: "2024-02-29"},
+		{format: "%Y%%%m%%%d", value: "2015%10%21", want: "2015-10-21"},
+		{format: "%d/%m/%Y", value: "2015-10-21", wantErr: dbtypes.ErrInvalidDateFormat},
+		{format: "%d/%m/%Y", value: "31/02/2015", wantErr: dbtypes.ErrDateOutOfRange},
+		{format: "%Y-W%V", value: "2015-W42", wantErr: dbtypes.ErrInvalidDateFormat},
+		{format: "%Y-%m-%d", value: "", wantErr: dbtypes.ErrEmptyDate},
+		{format: "%Y-%m-%d (copy 2)", value: "2015-10-21 (copy 2)", want: "2015-10-21"},
+		{format: "Invoice %d/%m/%Y Jan", value: "Invoice 21/10/2015 Jan", want: "2015-10-21"},
+		{format: "%Y-%m-%d 12 PM MST Mon", value: "2015-10-21 12 PM MST Mon", want: "2015-10-21"},
+		{format: "%Q %Y-%m-%d", value: "%Q 2015-10-21", want: "2015-10-21"},
+		{format: "%Y-%m-%d (copy 2)", value: "2015-10-21 (copy 3)", wantErr: dbtypes.ErrInvalidDateFormat},
+		{format: "%Y-%m-%d", value: "2015-10-21 trailing", wantErr: dbtypes.ErrInvalidDateFormat},
+		{format: "%Y-%m-%d", value: "2015-10", wantErr: dbtypes.ErrInvalidDateFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+" "+tt.value, func(t *testing.T) {
+			got, err := dbtypes.ParseStrftime(tt.format, tt.value)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseStrftime() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseStrftime() returned error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseStrftime() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStrftimeRoundTrip(t *testing.T) {
+	formats := []string{
+		"%Y-%m-%d 12",
+		"%Y-%m-%d (copy 2)",
+		"Invoice %d/%m/%Y Jan",
+		"%A %e %B %Y, 1 of 2",
+		"%a %b %d %y MST",
+		"day %j of %Y 2006",
+		"%F 01:02 PM",
+	}
+	dates := []dbtypes.Date{
+		dbtypes.NewDateUTC(2015, time.October, 21),
+		dbtypes.NewDateUTC(2024, time.February, 29),
+		dbtypes.NewDateUTC(1999, time.January, 5),
+	}
+
+	for _, format := range formats {
+		for _, date := range dates {
+			s := date.Strftime(format)
+			got, err := dbtypes.ParseStrftime(format, s)
+			if err != nil {
+				t.Errorf("ParseStrftime(%q, %q) returned error: %v", format, s, err)
+				continue
+			}
+			if got != date {
+				t.Errorf("ParseStrftime(%q, %q) = %s, want %s", format, s, got, date)
+			}
+		}
+	}
+}
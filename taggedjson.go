@@ -0,0 +1,306 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrUnknownVariant is returned by TaggedJSON for discriminator values and
+// types that were not registered with RegisterVariant.
+var ErrUnknownVariant = errors.New("unknown JSON variant")
+
+// VariantOption configures a VariantRegistry.
+type VariantOption func(*variantConfig)
+
+type variantConfig struct {
+	unknownAsMap bool
+}
+
+// VariantUnknownAsMap makes TaggedJSON.Decode return unknown variants as
+// a JSON map instead of an error wrapping ErrUnknownVariant.
+func VariantUnknownAsMap() VariantOption {
+	return func(cfg *variantConfig) {
+		cfg.unknownAsMap = true
+	}
+}
+
+// VariantRegistry maps the discriminators of TaggedJSON values to the Go
+// types registered with RegisterVariant. Columns with different
+// discriminator keys or variants use separate registries.
+// A VariantRegistry is safe for concurrent use.
+type VariantRegistry struct {
+	key string
+	cfg variantConfig
+
+	mu       sync.RWMutex
+	variants map[string]reflect.Type
+	tags     map[reflect.Type]string
+}
+
+// NewVariantRegistry returns an empty registry whose discriminator
+// is the object member key.
+func NewVariantRegistry(key string, opts ...VariantOption) *VariantRegistry {
+	reg := &VariantRegistry{
+		key:      key,
+		variants: make(map[string]reflect.Type),
+		tags:     make(map[reflect.Type]string),
+	}
+	for _, opt := range opts {
+		opt(&reg.cfg)
+	}
+	return reg
+}
+
+// DefaultVariants is the registry of TaggedJSON values without a Registry.
+// Its discriminator is "type" and unknown variants are errors.
+var DefaultVariants = NewVariantRegistry("type")
+
+// RegisterVariant registers T in reg as the type of TaggedJSON values whose
+// discriminator is tag. T should marshal to a JSON object, usually a struct.
+// It panics if tag is empty or either tag or T is already registered
+// with another type or tag.
+func RegisterVariant[T any](reg *VariantRegistry, tag string) {
+	typ := reflect.TypeFor[T]()
+	if tag == "" {
+		panic(fmt.Sprintf("dbtypes: empty variant tag for %s", typ))
+	}
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if other, ok := reg.variants[tag]; ok && other != typ {
+		panic(fmt.Sprintf("dbtypes: variant tag %q registered for both %s and %s", tag, other, typ))
+	}
+	if other, ok := reg.tags[typ]; ok && other != tag {
+		panic(fmt.Sprintf("dbtypes: variant %s registered as both %q and %q", typ, other, tag))
+	}
+	reg.variants[tag] = typ
+	reg.tags[typ] = tag
+}
+
+// Key returns the discriminator member of reg.
+func (reg *VariantRegistry) Key() string {
+	return reg.key
+}
+
+// variant returns the type registered for tag.
+func (reg *VariantRegistry) variant(tag string) (reflect.Type, bool) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	typ, ok := reg.variants[tag]
+	return typ, ok
+}
+
+// tag returns the discriminator of v, a registered variant, a pointer to
+// one or a JSON map holding its discriminator.
+func (reg *VariantRegistry) tag(v interface{}) (string, error) {
+	if obj, ok := asObject(v); ok {
+		tag, ok := obj[reg.key].(string)
+		if !ok {
+			return "", fmt.Errorf("%q: %w", reg.key, ErrKeyNotFound)
+		}
+		return tag, nil
+	}
+
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	typ := reflect.TypeOf(v)
+	if tag, ok := reg.tags[typ]; ok {
+		return tag, nil
+	}
+	if typ.Kind() == reflect.Pointer {
+		if tag, ok := reg.tags[typ.Elem()]; ok {
+			return tag, nil
+		}
+	}
+	return "", fmt.Errorf("%s: %w", typ, ErrUnknownVariant)
+}
+
+// Wrap returns a TaggedJSON of reg holding v, see NewTaggedJSON.
+func (reg *VariantRegistry) Wrap(v interface{}) TaggedJSON {
+	return TaggedJSON{Registry: reg, data: v}
+}
+
+// TaggedJSON is a JSON column holding one of several object shapes,
+// discriminated by a member like {"type":"user.created",...}. Each shape
+// is a Go type registered with RegisterVariant in Registry, or in
+// DefaultVariants if Registry is nil.
+//
+// Scanned values are kept as JSON text until Decode is called, and
+// written back unchanged. Scan leaves Registry as is, so it may be set
+// before or after scanning. A zero TaggedJSON is NULL.
+type TaggedJSON struct {
+	Registry *VariantRegistry
+
+	data interface{}
+	raw  RawJSON
+}
+
+// NewTaggedJSON returns a TaggedJSON of DefaultVariants holding v, a value
+// of a registered variant type or a pointer to one. A JSON map is written
+// as is. Use VariantRegistry.Wrap for other registries.
+func NewTaggedJSON(v interface{}) TaggedJSON {
+	return DefaultVariants.Wrap(v)
+}
+
+// registry returns the registry of t.
+func (t TaggedJSON) registry() *VariantRegistry {
+	if t.Registry == nil {
+		return DefaultVariants
+	}
+	return t.Registry
+}
+
+// Scan implements the sql.Scanner interface. It accepts JSON text as
+// []byte or string and NULL, see RawJSON.Scan.
+func (t *TaggedJSON) Scan(value interface{}) error {
+	switch value.(type) {
+	case nil, []byte, string:
+	default:
+		return &ScanTypeError{Value: value, Target: "TaggedJSON"}
+	}
+
+	var raw RawJSON
+	if err := raw.Scan(value); err != nil {
+		return err
+	}
+	t.data, t.raw = nil, raw
+	return nil
+}
+
+// IsNull reports whether t is NULL or the JSON literal null.
+func (t TaggedJSON) IsNull() bool {
+	if t.data != nil {
+		return false
+	}
+	trimmed := bytes.TrimSpace(t.raw)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
+
+// Decode returns the value of t and its discriminator. Registered variants
+// are decoded into a value of their type, e.g. UserCreated rather than
+// *UserCreated. NULL returns a nil value and an empty tag.
+//
+// Unknown discriminators result in an error wrapping ErrUnknownVariant, or
+// the document as a JSON map if the registry has VariantUnknownAsMap.
+// A missing discriminator results in an error wrapping ErrKeyNotFound and
+// one that is not a string in ErrUnexpectedType.
+func (t TaggedJSON) Decode() (interface{}, string, error) {
+	reg := t.registry()
+	if t.data != nil {
+		tag, err := reg.tag(t.data)
+		if err != nil {
+			return nil, "", err
+		}
+		return t.data, tag, nil
+	}
+	if t.IsNull() {
+		return nil, "", nil
+	}
+
+	if err := checkJSONLimits(t.raw); err != nil {
+		return nil, "", err
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(t.raw, &members); err != nil {
+		if t.raw.Validate() == nil {
+			return nil, "", ErrNotObject
+		}
+		return nil, "", err
+	}
+
+	tagValue, ok := members[reg.key]
+	if !ok {
+		return nil, "", fmt.Errorf("%q: %w", reg.key, ErrKeyNotFound)
+	}
+	var tag string
+	if err := json.Unmarshal(tagValue, &tag); err != nil {
+		return nil, "", fmt.Errorf("%q: %w", reg.key, ErrUnexpectedType)
+	}
+
+	typ, ok := reg.variant(tag)
+	if !ok {
+		if !reg.cfg.unknownAsMap {
+			return nil, tag, fmt.Errorf("%q: %w", tag, ErrUnknownVariant)
+		}
+		obj, err := decodeJSONObject(t.raw)
+		if err != nil {
+			return nil, tag, err
+		}
+		return JSON(obj), tag, nil
+	}
+
+	ptr := reflect.New(typ)
+	if err := decodeJSON(t.raw, ptr.Interface()); err != nil {
+		return nil, tag, fmt.Errorf("variant %q: %w", tag, err)
+	}
+	return ptr.Elem().Interface(), tag, nil
+}
+
+// Value implements the driver.Valuer interface. Scanned values are
+// returned unchanged. Values from NewTaggedJSON are marshaled with their
+// discriminator set, replacing any member of the same name.
+func (t TaggedJSON) Value() (driver.Value, error) {
+	if t.data == nil {
+		return t.raw.Value()
+	}
+
+	data, err := t.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// marshal returns the JSON text of t.data with its discriminator.
+func (t TaggedJSON) marshal() ([]byte, error) {
+	reg := t.registry()
+	tag, err := reg.tag(t.data)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(t.data)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil || obj == nil {
+		return nil, fmt.Errorf("variant %q: %w", tag, ErrNotObject)
+	}
+
+	obj[reg.key] = tag
+	return json.Marshal(obj)
+}
+
+// Custom function used by the gorm ORM if used.
+func (t TaggedJSON) GormDataType() string {
+	return "jsonb"
+}
+
+// MarshalJSON marshals t like Value, or null if t is NULL.
+func (t TaggedJSON) MarshalJSON() ([]byte, error) {
+	if t.data != nil {
+		return t.marshal()
+	}
+	return t.raw.MarshalJSON()
+}
+
+// UnmarshalJSON stores a copy of data to be decoded by Decode.
+func (t *TaggedJSON) UnmarshalJSON(data []byte) error {
+	var raw RawJSON
+	if err := raw.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	t.data, t.raw = nil, raw
+	return nil
+}
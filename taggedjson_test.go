@@ -0,0 +1,309 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+type userCreated struct {
+	UserID int    `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+type orderPlaced struct {
+	OrderID string  `json:"order_id"`
+	Total   float64 `json:"total"`
+	Kind    string  `json:"type,omitempty"`
+}
+
+func init() {
+	dbtypes.RegisterVariant[userCreated](dbtypes.DefaultVariants, "user.created")
+	dbtypes.RegisterVariant[orderPlaced](dbtypes.DefaultVariants, "order.placed")
+}
+
+func TestTaggedJSONDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    interface{}
+		wantTag string
+		wantErr error
+	}{
+		{
+			name:    "first variant",
+			value:   `{"type":"user.created","user_id":7,"email":"ann@example.com"}`,
+			want:    userCreated{UserID: 7, Email: "ann@example.com"},
+			wantTag: "user.created",
+		},
+		{
+			name:    "second variant",
+			value:   []byte(`{"total":12.5,"order_id":"A-1","type":"order.placed"}`),
+			want:    orderPlaced{OrderID: "A-1", Total: 12.5, Kind: "order.placed"},
+			wantTag: "order.placed",
+		},
+		{
+			name:    "unknown tag",
+			value:   `{"type":"user.deleted","user_id":7}`,
+			wantTag: "user.deleted",
+			wantErr: dbtypes.ErrUnknownVariant,
+		},
+		{
+			name:    "missing tag",
+			value:   `{"user_id":7}`,
+			wantErr: dbtypes.ErrKeyNotFound,
+		},
+		{
+			name:    "tag not a string",
+			value:   `{"type":1}`,
+			wantErr: dbtypes.ErrUnexpectedType,
+		},
+		{
+			name:    "not an object",
+			value:   `["user.created"]`,
+			wantErr: dbtypes.ErrNotObject,
+		},
+		{name: "NULL", value: nil},
+		{name: "null literal", value: "null"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tj dbtypes.TaggedJSON
+			if err := tj.Scan(tt.value); err != nil {
+				t.Fatalf("Scan() returned error: %v", err)
+			}
+
+			got, tag, err := tj.Decode()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decode() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) || tag != tt.wantTag {
+				t.Errorf("Decode() = %#v, %q, want %#v, %q", got, tag, tt.want, tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestTaggedJSONUnknownAsMap(t *testing.T) {
+	reg := dbtypes.NewVariantRegistry("type", dbtypes.VariantUnknownAsMap())
+	dbtypes.RegisterVariant[userCreated](reg, "user.created")
+
+	tj := dbtypes.TaggedJSON{Registry: reg}
+	if err := tj.Scan(`{"type":"user.deleted","user_id":7}`); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	got, tag, err := tj.Decode()
+	if err != nil {
+		t.Fatalf("Decode() returned error: %v", err)
+	}
+	want := dbtypes.JSON{"type": "user.deleted", "user_id": 7.0}
+	if !reflect.DeepEqual(got, want) || tag != "user.deleted" {
+		t.Errorf("Decode() = %#v, %q, want %#v, user.deleted", got, tag, want)
+	}
+
+	// Unknown variants are written back as they are.
+	value, err := reg.Wrap(got).Value()
+	if err != nil || value != `{"type":"user.deleted","user_id":7}` {
+		t.Errorf("Value() = %v, %v", value, err)
+	}
+}
+
+func TestTaggedJSONValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		tj      dbtypes.TaggedJSON
+		want    interface{}
+		wantErr error
+	}{
+		{name: "NULL", tj: dbtypes.TaggedJSON{}, want: nil},
+		{
+			name: "variant",
+			tj:   dbtypes.NewTaggedJSON(userCreated{UserID: 7, Email: "ann@example.com"}),
+			want: `{"email":"ann@example.com","type":"user.created","user_id":7}`,
+		},
+		{
+			name: "pointer to variant",
+			tj:   dbtypes.NewTaggedJSON(&userCreated{UserID: 8}),
+			want: `{"email":"","type":"user.created","user_id":8}`,
+		},
+		{
+			name: "tag replaces member",
+			tj:   dbtypes.NewTaggedJSON(orderPlaced{OrderID: "A-1", Total: 1e21, Kind: "stale"}),
+			want: `{"order_id":"A-1","total":1e+21,"type":"order.placed"}`,
+		},
+		{
+			name:    "unregistered type",
+			tj:      dbtypes.NewTaggedJSON(struct{ A int }{1}),
+			wantErr: dbtypes.ErrUnknownVariant,
+		},
+		{
+			name:    "map without tag",
+			tj:      dbtypes.NewTaggedJSON(dbtypes.JSON{"a": 1}),
+			wantErr: dbtypes.ErrKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.tj.Value()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Value() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVariantRegistryKey(t *testing.T) {
+	reg := dbtypes.NewVariantRegistry("kind")
+	dbtypes.RegisterVariant[userCreated](reg, "user")
+	if got := reg.Key(); got != "kind" {
+		t.Errorf("Key() = %q, want kind", got)
+	}
+
+	value, err := reg.Wrap(userCreated{UserID: 7}).Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if want := `{"email":"","kind":"user","user_id":7}`; value != want {
+		t.Errorf("Value() = %v, want %v", value, want)
+	}
+
+	// The registry may be set after scanning.
+	var tj dbtypes.TaggedJSON
+	if err := tj.Scan(value); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+	if _, _, err := tj.Decode(); !errors.Is(err, dbtypes.ErrKeyNotFound) {
+		t.Errorf("Decode() with DefaultVariants = %v, want ErrKeyNotFound", err)
+	}
+	tj.Registry = reg
+	if got, tag, err := tj.Decode(); err != nil || got != (userCreated{UserID: 7}) || tag != "user" {
+		t.Errorf("Decode() = %#v, %q, %v", got, tag, err)
+	}
+
+	// Both registries coexist.
+	if got, err := dbtypes.NewTaggedJSON(userCreated{UserID: 7}).Value(); err != nil || got != `{"email":"","type":"user.created","user_id":7}` {
+		t.Errorf("DefaultVariants Value() = %v, %v", got, err)
+	}
+}
+
+func TestVariantRegistryConcurrent(t *testing.T) {
+	reg := dbtypes.NewVariantRegistry("type")
+	dbtypes.RegisterVariant[userCreated](reg, "user.created")
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			dbtypes.RegisterVariant[orderPlaced](reg, "order.placed")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		tj := dbtypes.TaggedJSON{Registry: reg}
+		for i := 0; i < 100; i++ {
+			if err := tj.Scan(`{"type":"user.created","user_id":7}`); err != nil {
+				t.Error(err)
+				return
+			}
+			if _, _, err := tj.Decode(); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func TestTaggedJSONDatabase(t *testing.T) {
+	db := openFakeDB(t)
+
+	order := orderPlaced{OrderID: "A-1", Total: 12.5}
+	if _, err := db.Exec("INSERT", dbtypes.NewTaggedJSON(userCreated{UserID: 7}), dbtypes.NewTaggedJSON(order), dbtypes.TaggedJSON{}); err != nil {
+		t.Fatalf("Exec() returned error: %v", err)
+	}
+
+	var user, placed, null dbtypes.TaggedJSON
+	if err := db.QueryRow("SELECT").Scan(&user, &placed, &null); err != nil {
+		t.Fatalf("Scan() returned error: %v", err)
+	}
+
+	if got, tag, err := user.Decode(); err != nil || got != (userCreated{UserID: 7}) || tag != "user.created" {
+		t.Errorf("Decode() = %#v, %q, %v", got, tag, err)
+	}
+	order.Kind = "order.placed"
+	if got, tag, err := placed.Decode(); err != nil || got != order || tag != "order.placed" {
+		t.Errorf("Decode() = %#v, %q, %v", got, tag, err)
+	}
+	if !null.IsNull() {
+		t.Errorf("IsNull() = false for NULL")
+	}
+}
+
+func TestTaggedJSONMarshalJSON(t *testing.T) {
+	type event struct {
+		Payload dbtypes.TaggedJSON `json:"payload"`
+	}
+
+	data, err := json.Marshal(event{Payload: dbtypes.NewTaggedJSON(userCreated{UserID: 7})})
+	if err != nil {
+		t.Fatalf("Marshal() returned error: %v", err)
+	}
+	if want := `{"payload":{"email":"","type":"user.created","user_id":7}}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got event
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() returned error: %v", err)
+	}
+	if v, _, err := got.Payload.Decode(); err != nil || v != (userCreated{UserID: 7}) {
+		t.Errorf("Decode() = %#v, %v", v, err)
+	}
+
+	if data, err := json.Marshal(event{}); err != nil || string(data) != `{"payload":null}` {
+		t.Errorf("Marshal(NULL) = %s, %v", data, err)
+	}
+}
+
+func TestTaggedJSONScanErrors(t *testing.T) {
+	var tj dbtypes.TaggedJSON
+	var typeErr *dbtypes.ScanTypeError
+	if err := tj.Scan(42); !errors.As(err, &typeErr) || typeErr.Target != "TaggedJSON" {
+		t.Errorf("Scan(42) = %v, want *ScanTypeError for TaggedJSON", err)
+	}
+}
+
+func TestRegisterVariantPanics(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func()
+	}{
+		{"empty tag", func() { dbtypes.RegisterVariant[userCreated](dbtypes.DefaultVariants, "") }},
+		{"tag taken", func() { dbtypes.RegisterVariant[struct{ B int }](dbtypes.DefaultVariants, "user.created") }},
+		{"type taken", func() { dbtypes.RegisterVariant[userCreated](dbtypes.DefaultVariants, "user.renamed") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RegisterVariant() did not panic")
+				}
+			}()
+			tt.register()
+		})
+	}
+
+	// Registering the same variant again is allowed.
+	dbtypes.RegisterVariant[userCreated](dbtypes.DefaultVariants, "user.created")
+}
@@ -0,0 +1,79 @@
+package dbtypes
+
+import (
+	"text/template"
+	"time"
+)
+
+// TemplateFuncs returns functions for use with text/template and html/template
+// (whose FuncMap is an alias of the text/template one):
+//
+//	formatDate d layout  formats d with a Go layout, "" for zero dates
+//	addDays d n          adds n days to d, zero dates stay zero
+//	daysBetween a b      the number of days between a and b, 0 if either is zero
+//	today                Today()
+//	isZeroDate d         reports whether d is missing or zero
+//	humanizeDate d       d relative to today, e.g. "3 days ago", "" for zero dates
+//
+// Dates may be given as Date, *Date, NullDate, *NullDate, time.Time or
+// *time.Time. Nil pointers and other values are treated as zero dates, so the
+// functions never fail on missing data.
+//
+//	tmpl := template.New("page").Funcs(dbtypes.TemplateFuncs())
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"formatDate": func(d interface{}, layout string) string {
+			return templateDate(d).Format(layout)
+		},
+		"addDays": func(d interface{}, days int) Date {
+			date := templateDate(d)
+			if date.IsZero() {
+				return Date{}
+			}
+			return date.AddDays(days)
+		},
+		"daysBetween": func(a, b interface{}) int {
+			from, to := templateDate(a), templateDate(b)
+			if from.IsZero() || to.IsZero() {
+				return 0
+			}
+			return from.DaysBetween(to)
+		},
+		"today": Today,
+		"isZeroDate": func(d interface{}) bool {
+			return templateDate(d).IsZero()
+		},
+		"humanizeDate": func(d interface{}) string {
+			return templateDate(d).Humanize(Today())
+		},
+	}
+}
+
+// templateDate converts a template argument to a Date.
+func templateDate(v interface{}) Date {
+	switch v := v.(type) {
+	case Date:
+		return v
+	case *Date:
+		if v != nil {
+			return *v
+		}
+	case NullDate:
+		if v.Valid {
+			return v.Date
+		}
+	case *NullDate:
+		if v != nil && v.Valid {
+			return v.Date
+		}
+	case time.Time:
+		if !v.IsZero() {
+			return DateFromTime(v)
+		}
+	case *time.Time:
+		if v != nil && !v.IsZero() {
+			return DateFromTime(*v)
+		}
+	}
+	return Date{}
+}
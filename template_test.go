@@ -0,0 +1,81 @@
+package dbtypes_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+type templateVisit struct {
+	Date     dbtypes.Date
+	Next     *dbtypes.Date
+	Followup dbtypes.NullDate
+	Created  time.Time
+}
+
+func TestTemplateFuncs(t *testing.T) {
+	date := dbtypes.NewDateUTC(2015, time.October, 21)
+	next := date.AddDays(14)
+	visit := templateVisit{
+		Date:     date,
+		Next:     &next,
+		Followup: dbtypes.NullDateFrom(date.AddDays(30)),
+		Created:  time.Date(2015, time.October, 20, 18, 30, 0, 0, time.UTC),
+	}
+	yesterday := dbtypes.Yesterday()
+
+	tests := []struct {
+		name string
+		text string
+		data interface{}
+		want string
+	}{
+		{name: "format", text: `{{ formatDate .Date "02 Jan 2006" }}`, data: visit, want: "21 Oct 2015"},
+		{name: "format pointer", text: `{{ formatDate .Next "02 Jan 2006" }}`, data: visit, want: "04 Nov 2015"},
+		{name: "format null date", text: `{{ formatDate .Followup "2006-01-02" }}`, data: visit, want: "2015-11-20"},
+		{name: "format time", text: `{{ formatDate .Created "2006-01-02" }}`, data: visit, want: "2015-10-20"},
+		{name: "format nil", text: `[{{ formatDate .Next "2006-01-02" }}]`, data: templateVisit{}, want: "[]"},
+		{name: "add days", text: `{{ addDays .Date 11 }}`, data: visit, want: "2015-11-01"},
+		{name: "add days nil", text: `[{{ addDays .Next 1 }}]`, data: templateVisit{}, want: "[]"},
+		{name: "days between", text: `{{ daysBetween .Date .Next }}`, data: visit, want: "14"},
+		{name: "days between nil", text: `{{ daysBetween .Date .Next }}`, data: templateVisit{Date: date}, want: "0"},
+		{name: "today", text: `{{ today }}`, data: nil, want: dbtypes.Today().String()},
+		{name: "is zero", text: `{{ isZeroDate .Date }} {{ isZeroDate .Next }}`, data: templateVisit{Date: date}, want: "false true"},
+		{name: "humanize", text: `{{ humanizeDate . }}`, data: &yesterday, want: "yesterday"},
+		{name: "humanize nil", text: `[{{ humanizeDate .Next }}]`, data: templateVisit{}, want: "[]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New(tt.name).Funcs(dbtypes.TemplateFuncs()).Parse(tt.text)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.text, err)
+			}
+
+			var b strings.Builder
+			if err := tmpl.Execute(&b, tt.data); err != nil {
+				t.Fatalf("Execute(%q) returned error: %v", tt.text, err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("Execute(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateFuncsHTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("visit").Funcs(dbtypes.TemplateFuncs()).
+		Parse(`<td>{{ formatDate .Date "Jan 2, 2006" }}</td><td>{{ formatDate .Next "Jan 2, 2006" }}</td>`))
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, templateVisit{Date: dbtypes.NewDateUTC(2015, time.October, 21)}); err != nil {
+		t.Fatalf("Execute() returned error: %v", err)
+	}
+	if want := "<td>Oct 21, 2015</td><td></td>"; b.String() != want {
+		t.Errorf("Execute() = %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,195 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Week is an ISO 8601 week, running from Monday to Sunday, stored as
+// "GGGG-Www" like "2023-W42". Year is the ISO week-numbering year, which
+// differs from the calendar year for days around New Year, e.g.
+// 2024-12-30 is in 2025-W01. The zero Week represents no week.
+type Week struct {
+	Year int
+	Week int
+}
+
+// NewWeek returns the given ISO week. Week must be between 1 and the number
+// of weeks in the ISO year (52 or 53), otherwise an error wrapping
+// ErrDateOutOfRange is returned.
+func NewWeek(year, week int) (Week, error) {
+	if week < 1 || week > isoWeeksInYear(year) {
+		return Week{}, fmt.Errorf("%w: week %d of %d", ErrDateOutOfRange, week, year)
+	}
+	return Week{Year: year, Week: week}, nil
+}
+
+// WeekOf returns the ISO week in which the date occurs.
+// The zero date returns the zero Week.
+func WeekOf(date Date) Week {
+	if date.IsZero() {
+		return Week{}
+	}
+
+	year, week := date.ISOWeek()
+	return Week{Year: year, Week: week}
+}
+
+// ParseWeek parses an ISO 8601 week like "2023-W42".
+// Errors are of type *ParseError.
+func ParseWeek(s string) (Week, error) {
+	if strings.TrimSpace(s) == "" {
+		return Week{}, &ParseError{Input: s, Layout: isoWeekLayout, Err: ErrEmptyDate}
+	}
+
+	yearStr, weekStr, ok := strings.Cut(s, "-W")
+	if !ok || len(yearStr) < 4 || len(weekStr) != 2 {
+		return Week{}, &ParseError{Input: s, Layout: isoWeekLayout, Err: ErrInvalidDateFormat}
+	}
+
+	year, err := strconv.Atoi(yearStr)
+	if err != nil {
+		return Week{}, &ParseError{Input: s, Layout: isoWeekLayout, Err: ErrInvalidDateFormat}
+	}
+
+	week, err := strconv.Atoi(weekStr)
+	if err != nil {
+		return Week{}, &ParseError{Input: s, Layout: isoWeekLayout, Err: ErrInvalidDateFormat}
+	}
+
+	if week < 1 || week > isoWeeksInYear(year) {
+		return Week{}, &ParseError{Input: s, Layout: isoWeekLayout, Err: ErrDateOutOfRange}
+	}
+	return Week{Year: year, Week: week}, nil
+}
+
+// IsZero reports whether w is the zero Week.
+func (w Week) IsZero() bool {
+	return w == Week{}
+}
+
+// Start returns the Monday of the week in the default location
+// (see SetDefaultLocation). The zero Week returns the zero date.
+func (w Week) Start() Date {
+	if w.IsZero() {
+		return Date{}
+	}
+	return isoWeekStart(w.Year, w.Week)
+}
+
+// End returns the Sunday of the week in the default location.
+// The zero Week returns the zero date.
+func (w Week) End() Date {
+	if w.IsZero() {
+		return Date{}
+	}
+	return w.Start().AddDays(6)
+}
+
+// Next returns the following week. The zero Week is returned unchanged.
+func (w Week) Next() Week {
+	if w.IsZero() {
+		return w
+	}
+	return WeekOf(w.Start().AddDays(7))
+}
+
+// Prev returns the preceding week. The zero Week is returned unchanged.
+func (w Week) Prev() Week {
+	if w.IsZero() {
+		return w
+	}
+	return WeekOf(w.Start().AddDays(-7))
+}
+
+// Contains reports whether the date falls within the week.
+func (w Week) Contains(date Date) bool {
+	return !w.IsZero() && WeekOf(date) == w
+}
+
+// String returns the week formatted like "2023-W42",
+// or an empty string for the zero Week.
+func (w Week) String() string {
+	if w.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-W%02d", w.Year, w.Week)
+}
+
+// Scan implements the sql.Scanner interface for weeks stored as strings.
+// NULL and empty strings scan as the zero Week.
+func (w *Week) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*w = Week{}
+		return nil
+	case string:
+		return w.UnmarshalText([]byte(v))
+	case []byte:
+		return w.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into Week", value)
+}
+
+// Value implements the driver.Valuer interface, storing the week as a
+// string like "2023-W42". The zero Week is stored as NULL.
+func (w Week) Value() (driver.Value, error) {
+	if w.IsZero() {
+		return nil, nil
+	}
+	return w.String(), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (w Week) GormDataType() string {
+	return "string"
+}
+
+// MarshalJSON marshals the week as a string like "2023-W42",
+// or null for the zero Week.
+func (w Week) MarshalJSON() ([]byte, error) {
+	if w.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON parses a week string. null and "" set the zero Week.
+func (w *Week) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*w = Week{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &ParseError{Input: string(data), Layout: isoWeekLayout, Err: ErrInvalidDateFormat}
+	}
+	return w.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The zero Week is empty text.
+func (w Week) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text sets the zero Week.
+func (w *Week) UnmarshalText(text []byte) error {
+	if strings.TrimSpace(string(text)) == "" {
+		*w = Week{}
+		return nil
+	}
+
+	week, err := ParseWeek(string(text))
+	if err != nil {
+		return err
+	}
+	*w = week
+	return nil
+}
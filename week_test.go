@@ -0,0 +1,213 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestNewWeek(t *testing.T) {
+	tests := []struct {
+		year, week int
+		wantErr    bool
+	}{
+		{year: 2023, week: 42},
+		{year: 2020, week: 53},
+		{year: 2026, week: 53},
+		{year: 2023, week: 53, wantErr: true},
+		{year: 2020, week: 54, wantErr: true},
+		{year: 2023, week: 0, wantErr: true},
+		{year: 2023, week: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := dbtypes.NewWeek(tt.year, tt.week)
+		if tt.wantErr {
+			if !errors.Is(err, dbtypes.ErrDateOutOfRange) {
+				t.Errorf("NewWeek(%d, %d) error = %v, want ErrDateOutOfRange", tt.year, tt.week, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("NewWeek(%d, %d) returned error: %v", tt.year, tt.week, err)
+		}
+		if got.Year != tt.year || got.Week != tt.week {
+			t.Errorf("NewWeek(%d, %d) = %+v", tt.year, tt.week, got)
+		}
+	}
+}
+
+func TestParseWeek(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantStart string
+		wantEnd   string
+		wantErr   error
+	}{
+		{input: "2023-W42", wantStart: "2023-10-16", wantEnd: "2023-10-22"},
+		{input: "2020-W53", wantStart: "2020-12-28", wantEnd: "2021-01-03"},
+		{input: "2025-W01", wantStart: "2024-12-30", wantEnd: "2025-01-05"},
+		{input: "2021-W01", wantStart: "2021-01-04", wantEnd: "2021-01-10"},
+		{input: "2023-W53", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2020-W54", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-W00", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-W5", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023W42", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "", wantErr: dbtypes.ErrEmptyDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := dbtypes.ParseWeek(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseWeek(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseWeek(%q) returned error: %v", tt.input, err)
+			}
+			if got.String() != tt.input {
+				t.Errorf("ParseWeek(%q).String() = %s", tt.input, got)
+			}
+			if got.Start().String() != tt.wantStart || got.End().String() != tt.wantEnd {
+				t.Errorf("ParseWeek(%q) = %s..%s, want %s..%s", tt.input, got.Start(), got.End(), tt.wantStart, tt.wantEnd)
+			}
+			if got.Start().Weekday() != time.Monday || got.End().Weekday() != time.Sunday {
+				t.Errorf("ParseWeek(%q) runs %v to %v", tt.input, got.Start().Weekday(), got.End().Weekday())
+			}
+		})
+	}
+}
+
+func TestWeekOfYearBoundary(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{date: "2024-12-30", want: "2025-W01"},
+		{date: "2021-01-03", want: "2020-W53"},
+		{date: "2023-01-01", want: "2022-W52"},
+		{date: "2027-01-01", want: "2026-W53"},
+		{date: "2023-10-18", want: "2023-W42"},
+	}
+
+	for _, tt := range tests {
+		date := dbtypes.MustParseDate(tt.date)
+		week := dbtypes.WeekOf(date)
+		if week.String() != tt.want {
+			t.Errorf("WeekOf(%s) = %s, want %s", tt.date, week, tt.want)
+		}
+		if !week.Contains(date) {
+			t.Errorf("%s.Contains(%s) = false, want true", week, tt.date)
+		}
+		if week.Contains(date.AddDays(7)) || week.Contains(date.AddDays(-7)) {
+			t.Errorf("%s contains dates a week from %s", week, tt.date)
+		}
+	}
+
+	if got := dbtypes.WeekOf(dbtypes.Date{}); !got.IsZero() {
+		t.Errorf("WeekOf(zero) = %s, want zero", got)
+	}
+}
+
+func TestWeekNextPrev(t *testing.T) {
+	tests := []struct {
+		week, next, prev string
+	}{
+		{week: "2023-W42", next: "2023-W43", prev: "2023-W41"},
+		{week: "2020-W53", next: "2021-W01", prev: "2020-W52"},
+		{week: "2023-W52", next: "2024-W01", prev: "2023-W51"},
+		{week: "2025-W01", next: "2025-W02", prev: "2024-W52"},
+		{week: "2021-W01", next: "2021-W02", prev: "2020-W53"},
+	}
+
+	for _, tt := range tests {
+		week, err := dbtypes.ParseWeek(tt.week)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := week.Next().String(); got != tt.next {
+			t.Errorf("%s.Next() = %s, want %s", tt.week, got, tt.next)
+		}
+		if got := week.Prev().String(); got != tt.prev {
+			t.Errorf("%s.Prev() = %s, want %s", tt.week, got, tt.prev)
+		}
+	}
+}
+
+func TestWeekScanValue(t *testing.T) {
+	week, _ := dbtypes.NewWeek(2025, 1)
+
+	v, err := week.Value()
+	if err != nil || v != "2025-W01" {
+		t.Errorf("Value() = %v, %v, want 2025-W01", v, err)
+	}
+	if v, err := (dbtypes.Week{}).Value(); err != nil || v != nil {
+		t.Errorf("Value() of zero week = %v, %v, want nil", v, err)
+	}
+
+	tests := []struct {
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{value: "2025-W01", want: "2025-W01"},
+		{value: []byte("2020-W53"), want: "2020-W53"},
+		{value: nil, want: ""},
+		{value: "", want: ""},
+		{value: "2023-W53", wantErr: true},
+		{value: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := week
+		err := got.Scan(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && got.String() != tt.want {
+			t.Errorf("Scan(%v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestWeekJSON(t *testing.T) {
+	type report struct {
+		Week  dbtypes.Week `json:"week"`
+		Empty dbtypes.Week `json:"empty"`
+	}
+
+	week, _ := dbtypes.NewWeek(2020, 53)
+	data, err := json.Marshal(report{Week: week})
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `{"week":"2020-W53","empty":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got report
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned error: %v", data, err)
+	}
+	if got.Week != week || !got.Empty.IsZero() {
+		t.Errorf("json.Unmarshal(%s) = %+v", data, got)
+	}
+
+	for _, input := range []string{`"2023-W53"`, `42`, `"2023-10-16"`} {
+		var w dbtypes.Week
+		if err := json.Unmarshal([]byte(input), &w); err == nil {
+			t.Errorf("json.Unmarshal(%s) error = nil, want error", input)
+		}
+	}
+
+	text, err := week.MarshalText()
+	if err != nil || string(text) != "2020-W53" {
+		t.Errorf("MarshalText() = %q, %v, want 2020-W53", text, err)
+	}
+}
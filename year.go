@@ -0,0 +1,260 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Year is a calendar year stored as an integer column, e.g. a vehicle or
+// graduation year. The zero Year represents no year and is stored as NULL.
+type Year int
+
+// Bounds of valid years, see SetYearRange.
+var (
+	minYear Year = 1
+	maxYear Year = 9999
+)
+
+// SetYearRange sets the inclusive range of years accepted when scanning
+// and unmarshalling a Year. The default is 1 to 9999.
+// Out of range values result in a *YearRangeError.
+//
+//	dbtypes.SetYearRange(1900, dbtypes.Year(time.Now().Year()+1))
+//
+// It panics if min is not positive or is after max.
+// This should be called once at program startup.
+func SetYearRange(min, max Year) {
+	if min < 1 || min > max {
+		panic(fmt.Sprintf("dbtypes: invalid year range [%d, %d]", min, max))
+	}
+	minYear, maxYear = min, max
+}
+
+// YearRangeError is returned when a Year is outside the range set with
+// SetYearRange. It wraps ErrDateOutOfRange.
+type YearRangeError struct {
+	Year     Year
+	Min, Max Year
+}
+
+func (e *YearRangeError) Error() string {
+	return fmt.Sprintf("year %d is out of range [%d, %d]", e.Year, e.Min, e.Max)
+}
+
+func (e *YearRangeError) Unwrap() error {
+	return ErrDateOutOfRange
+}
+
+// Validate returns a *YearRangeError if y is outside the configured range.
+func (y Year) Validate() error {
+	if y < minYear || y > maxYear {
+		return &YearRangeError{Year: y, Min: minYear, Max: maxYear}
+	}
+	return nil
+}
+
+// ParseYear parses a decimal year like "2023" and validates it against
+// the configured range.
+func ParseYear(s string) (Year, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, &ParseError{Input: s, Layout: "2006", Err: ErrEmptyDate}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, &ParseError{Input: s, Layout: "2006", Err: ErrInvalidDateFormat}
+	}
+
+	y := Year(n)
+	if err := y.Validate(); err != nil {
+		return 0, err
+	}
+	return y, nil
+}
+
+// IsZero reports whether y is the zero Year.
+func (y Year) IsZero() bool {
+	return y == 0
+}
+
+// IsLeap reports whether y is a leap year.
+func (y Year) IsLeap() bool {
+	return IsLeapYear(int(y))
+}
+
+// Start returns January 1 of the year in the default location
+// (see SetDefaultLocation). The zero Year returns the zero date.
+func (y Year) Start() Date {
+	if y.IsZero() {
+		return Date{}
+	}
+	return NewDate(int(y), time.January, 1)
+}
+
+// End returns December 31 of the year in the default location.
+// The zero Year returns the zero date.
+func (y Year) End() Date {
+	if y.IsZero() {
+		return Date{}
+	}
+	return NewDate(int(y), time.December, 31)
+}
+
+// String returns the year in decimal, or an empty string for the zero Year.
+func (y Year) String() string {
+	if y.IsZero() {
+		return ""
+	}
+	return strconv.Itoa(int(y))
+}
+
+// YearsUntil returns an iterator over the year and every following year up
+// to and including end. Nothing is yielded if end is before the year.
+func (y Year) YearsUntil(end Year) iter.Seq[Year] {
+	return func(yield func(Year) bool) {
+		for year := y; year <= end; year++ {
+			if !yield(year) {
+				return
+			}
+		}
+	}
+}
+
+// Years returns every year from start to end, both inclusive.
+// It returns nil if end is before start.
+func Years(start, end Year) []Year {
+	if end < start {
+		return nil
+	}
+
+	years := make([]Year, 0, end-start+1)
+	for year := range start.YearsUntil(end) {
+		years = append(years, year)
+	}
+	return years
+}
+
+// Scan implements the sql.Scanner interface for integer and string columns.
+// NULL scans as the zero Year; other values must be in the configured range.
+func (y *Year) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*y = 0
+		return nil
+	case int64:
+		return y.set(Year(v))
+	case string:
+		return y.UnmarshalText([]byte(v))
+	case []byte:
+		return y.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into Year", value)
+}
+
+func (y *Year) set(year Year) error {
+	if err := year.Validate(); err != nil {
+		return err
+	}
+	*y = year
+	return nil
+}
+
+// Value implements the driver.Valuer interface, storing the year as an int64.
+// The zero Year is stored as NULL.
+func (y Year) Value() (driver.Value, error) {
+	if y.IsZero() {
+		return nil, nil
+	}
+	return int64(y), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (y Year) GormDataType() string {
+	return "int"
+}
+
+// MarshalJSON marshals the year as a bare number, or null for the zero Year.
+func (y Year) MarshalJSON() ([]byte, error) {
+	if y.IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, int64(y), 10), nil
+}
+
+// UnmarshalJSON accepts a number or a numeric string like "2023".
+// null sets the zero Year.
+func (y *Year) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*y = 0
+		return nil
+	}
+
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	return y.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The zero Year is empty text.
+func (y Year) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text sets the zero Year.
+func (y *Year) UnmarshalText(text []byte) error {
+	if strings.TrimSpace(string(text)) == "" {
+		*y = 0
+		return nil
+	}
+
+	year, err := ParseYear(string(text))
+	if err != nil {
+		return err
+	}
+	*y = year
+	return nil
+}
+
+// FormScan implements the FormScanner interface (see Date.FormScan).
+// value may be a string, []byte, []string (the first element is used) or an
+// integer. Empty strings are skipped.
+func (y *Year) FormScan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case []string:
+		if len(v) > 0 {
+			s = v[0]
+		}
+	case int:
+		return y.set(Year(v))
+	case int64:
+		return y.set(Year(v))
+	default:
+		return fmt.Errorf("invalid year. Expected value as a string")
+	}
+
+	if s == "" {
+		return nil
+	}
+
+	year, err := ParseYear(s)
+	if err != nil {
+		return err
+	}
+	*y = year
+	return nil
+}
@@ -0,0 +1,201 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestYearValidation(t *testing.T) {
+	dbtypes.SetYearRange(1900, dbtypes.Year(time.Now().Year()+1))
+	defer dbtypes.SetYearRange(1, 9999)
+
+	next := time.Now().Year() + 1
+	tests := []struct {
+		name    string
+		input   string
+		want    dbtypes.Year
+		wantErr error
+	}{
+		{name: "valid", input: "2023", want: 2023},
+		{name: "lower bound", input: "1900", want: 1900},
+		{name: "next year", input: dbtypes.Year(next).String(), want: dbtypes.Year(next)},
+		{name: "year zero", input: "0", wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "before range", input: "1899", wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "after range", input: dbtypes.Year(next + 1).String(), wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "negative", input: "-2023", wantErr: dbtypes.ErrDateOutOfRange},
+		{name: "not a number", input: "20x3", wantErr: dbtypes.ErrInvalidDateFormat},
+		{name: "empty", input: "", wantErr: dbtypes.ErrEmptyDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dbtypes.ParseYear(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseYear(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				if tt.wantErr == dbtypes.ErrDateOutOfRange {
+					var rangeErr *dbtypes.YearRangeError
+					if !errors.As(err, &rangeErr) || rangeErr.Min != 1900 || rangeErr.Max != dbtypes.Year(next) {
+						t.Errorf("ParseYear(%q) error = %#v, want *YearRangeError", tt.input, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseYear(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseYear(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetYearRangePanics(t *testing.T) {
+	for _, bounds := range [][2]dbtypes.Year{{0, 2000}, {2000, 1999}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetYearRange(%d, %d) did not panic", bounds[0], bounds[1])
+				}
+			}()
+			dbtypes.SetYearRange(bounds[0], bounds[1])
+		}()
+	}
+}
+
+func TestYearScanValue(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    dbtypes.Year
+		wantErr bool
+	}{
+		{value: int64(2023), want: 2023},
+		{value: "2023", want: 2023},
+		{value: []byte("1999"), want: 1999},
+		{value: nil, want: 0},
+		{value: int64(0), wantErr: true},
+		{value: int64(10000), wantErr: true},
+		{value: "MMXXIII", wantErr: true},
+		{value: 2023.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := dbtypes.Year(1)
+		err := got.Scan(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("Scan(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+
+	if v, err := dbtypes.Year(2023).Value(); err != nil || v != int64(2023) {
+		t.Errorf("Value() = %v, %v, want 2023", v, err)
+	}
+	if v, err := dbtypes.Year(0).Value(); err != nil || v != nil {
+		t.Errorf("Value() of zero = %v, %v, want nil", v, err)
+	}
+}
+
+func TestYearJSON(t *testing.T) {
+	type vehicle struct {
+		Year     dbtypes.Year `json:"year"`
+		Scrapped dbtypes.Year `json:"scrapped"`
+	}
+
+	in := vehicle{Year: 2015}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `{"year":2015,"scrapped":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got vehicle
+	if err := json.Unmarshal(data, &got); err != nil || got != in {
+		t.Errorf("json.Unmarshal(%s) = %+v, %v, want %+v", data, got, err, in)
+	}
+
+	var y dbtypes.Year
+	if err := json.Unmarshal([]byte(`"1999"`), &y); err != nil || y != 1999 {
+		t.Errorf(`json.Unmarshal("1999") = %d, %v, want 1999`, y, err)
+	}
+
+	for _, input := range []string{`0`, `10000`, `2015.5`, `true`} {
+		if err := json.Unmarshal([]byte(input), &y); err == nil {
+			t.Errorf("json.Unmarshal(%s) error = nil, want error", input)
+		}
+	}
+}
+
+func TestYearFormScan(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    dbtypes.Year
+		wantErr bool
+	}{
+		{value: "2023", want: 2023},
+		{value: []string{"2021", "2022"}, want: 2021},
+		{value: 2020, want: 2020},
+		{value: "", want: 1990},
+		{value: "0", wantErr: true},
+		{value: 3.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := dbtypes.Year(1990)
+		err := got.FormScan(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("FormScan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("FormScan(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestYearHelpers(t *testing.T) {
+	tests := []struct {
+		year   dbtypes.Year
+		isLeap bool
+		start  string
+		end    string
+	}{
+		{year: 2024, isLeap: true, start: "2024-01-01", end: "2024-12-31"},
+		{year: 1900, isLeap: false, start: "1900-01-01", end: "1900-12-31"},
+		{year: 2000, isLeap: true, start: "2000-01-01", end: "2000-12-31"},
+		{year: 0, isLeap: true, start: "", end: ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.year.IsLeap(); got != tt.isLeap {
+			t.Errorf("Year(%d).IsLeap() = %v, want %v", tt.year, got, tt.isLeap)
+		}
+		if got := tt.year.Start().String(); got != tt.start {
+			t.Errorf("Year(%d).Start() = %s, want %s", tt.year, got, tt.start)
+		}
+		if got := tt.year.End().String(); got != tt.end {
+			t.Errorf("Year(%d).End() = %s, want %s", tt.year, got, tt.end)
+		}
+	}
+
+	if got, want := dbtypes.Years(2019, 2022), []dbtypes.Year{2019, 2020, 2021, 2022}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Years(2019, 2022) = %v, want %v", got, want)
+	}
+	if got := dbtypes.Years(2022, 2019); got != nil {
+		t.Errorf("Years(2022, 2019) = %v, want nil", got)
+	}
+	if got := slices.Collect(dbtypes.Year(2020).YearsUntil(2020)); !reflect.DeepEqual(got, []dbtypes.Year{2020}) {
+		t.Errorf("YearsUntil(2020) = %v, want [2020]", got)
+	}
+}
@@ -0,0 +1,213 @@
+package dbtypes
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// YearMonthLayout is the layout of YearMonth strings.
+const YearMonthLayout = "2006-01"
+
+// YearMonth is a calendar month of a year, stored as "2006-01" like "2023-07".
+// The zero YearMonth represents no month.
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// NewYearMonth returns the given month. Like time.Date, months outside
+// 1-12 are normalized, so NewYearMonth(2023, 13) is 2024-01.
+func NewYearMonth(year int, month time.Month) YearMonth {
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return YearMonth{Year: t.Year(), Month: t.Month()}
+}
+
+// YearMonthOf returns the month in which the date occurs.
+// The zero date returns the zero YearMonth.
+func YearMonthOf(date Date) YearMonth {
+	if date.IsZero() {
+		return YearMonth{}
+	}
+
+	y, m, _ := date.civil()
+	return YearMonth{Year: y, Month: m}
+}
+
+// ParseYearMonth parses a month like "2023-07".
+// Errors are of type *ParseError.
+func ParseYearMonth(s string) (YearMonth, error) {
+	if strings.TrimSpace(s) == "" {
+		return YearMonth{}, &ParseError{Input: s, Layout: YearMonthLayout, Err: ErrEmptyDate}
+	}
+
+	t, err := time.Parse(YearMonthLayout, s)
+	if err != nil {
+		return YearMonth{}, &ParseError{Input: s, Layout: YearMonthLayout, Err: parseErrorCause(err)}
+	}
+	return YearMonth{Year: t.Year(), Month: t.Month()}, nil
+}
+
+// IsZero reports whether ym is the zero YearMonth.
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// FirstDay returns the first day of the month in the default location
+// (see SetDefaultLocation). The zero YearMonth returns the zero date.
+func (ym YearMonth) FirstDay() Date {
+	if ym.IsZero() {
+		return Date{}
+	}
+	return NewDate(ym.Year, ym.Month, 1)
+}
+
+// LastDay returns the last day of the month in the default location.
+// The zero YearMonth returns the zero date.
+func (ym YearMonth) LastDay() Date {
+	if ym.IsZero() {
+		return Date{}
+	}
+	return NewDate(ym.Year, ym.Month, daysInMonth(ym.Year, ym.Month))
+}
+
+// Contains reports whether the date falls within the month.
+func (ym YearMonth) Contains(date Date) bool {
+	return !ym.IsZero() && YearMonthOf(date) == ym
+}
+
+// AddMonths returns the month n months after ym, or before if n is negative.
+// The zero YearMonth is returned unchanged.
+func (ym YearMonth) AddMonths(n int) YearMonth {
+	if ym.IsZero() {
+		return ym
+	}
+	return NewYearMonth(ym.Year, ym.Month+time.Month(n))
+}
+
+// MonthsBetween returns the number of months between ym and other,
+// regardless of their order, e.g. 1 from 2023-12 to 2024-01.
+func (ym YearMonth) MonthsBetween(other YearMonth) int {
+	return abs(other.index() - ym.index())
+}
+
+// index returns the number of months since January of year 0.
+func (ym YearMonth) index() int {
+	return ym.Year*12 + int(ym.Month) - 1
+}
+
+// Compare returns -1 if ym is before other, +1 if it is after and 0 if they are equal.
+func (ym YearMonth) Compare(other YearMonth) int {
+	a, b := ym.index(), other.index()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// String returns the month formatted like "2023-07",
+// or an empty string for the zero YearMonth.
+func (ym YearMonth) String() string {
+	if ym.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d", ym.Year, int(ym.Month))
+}
+
+// Scan implements the sql.Scanner interface. It accepts strings like
+// "2023-07" as well as DATE values (time.Time or date strings), which are
+// truncated to their month. NULL and empty strings scan as the zero YearMonth.
+func (ym *YearMonth) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*ym = YearMonth{}
+		return nil
+	case time.Time:
+		*ym = YearMonthOf(DateFromTime(v))
+		return nil
+	case string:
+		return ym.scanText(v)
+	case []byte:
+		return ym.scanText(string(v))
+	}
+	return fmt.Errorf("cannot scan %T into YearMonth", value)
+}
+
+func (ym *YearMonth) scanText(s string) error {
+	s = strings.TrimSpace(s)
+	if len(s) > len(YearMonthLayout) {
+		var date Date
+		if err := date.scanText(s); err != nil {
+			return err
+		}
+		*ym = YearMonthOf(date)
+		return nil
+	}
+	return ym.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface, storing the month as a
+// string like "2023-07". Use FirstDay for DATE columns.
+// The zero YearMonth is stored as NULL.
+func (ym YearMonth) Value() (driver.Value, error) {
+	if ym.IsZero() {
+		return nil, nil
+	}
+	return ym.String(), nil
+}
+
+// Custom function used by the gorm ORM if used.
+func (ym YearMonth) GormDataType() string {
+	return "string"
+}
+
+// MarshalJSON marshals the month as a string like "2023-07",
+// or null for the zero YearMonth.
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	if ym.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ym.String())
+}
+
+// UnmarshalJSON parses a month string. null and "" set the zero YearMonth.
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*ym = YearMonth{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return &ParseError{Input: string(data), Layout: YearMonthLayout, Err: ErrInvalidDateFormat}
+	}
+	return ym.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The zero YearMonth is empty text.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text sets the zero YearMonth.
+func (ym *YearMonth) UnmarshalText(text []byte) error {
+	if strings.TrimSpace(string(text)) == "" {
+		*ym = YearMonth{}
+		return nil
+	}
+
+	parsed, err := ParseYearMonth(string(text))
+	if err != nil {
+		return err
+	}
+	*ym = parsed
+	return nil
+}
@@ -0,0 +1,197 @@
+package dbtypes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/abiiranathan/dbtypes"
+)
+
+func TestNewYearMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  string
+	}{
+		{year: 2023, month: time.July, want: "2023-07"},
+		{year: 2023, month: 13, want: "2024-01"},
+		{year: 2023, month: 0, want: "2022-12"},
+		{year: 2023, month: -11, want: "2022-01"},
+	}
+
+	for _, tt := range tests {
+		if got := dbtypes.NewYearMonth(tt.year, tt.month).String(); got != tt.want {
+			t.Errorf("NewYearMonth(%d, %d) = %s, want %s", tt.year, tt.month, got, tt.want)
+		}
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    dbtypes.YearMonth
+		wantErr error
+	}{
+		{input: "2023-07", want: dbtypes.YearMonth{Year: 2023, Month: time.July}},
+		{input: "1999-12", want: dbtypes.YearMonth{Year: 1999, Month: time.December}},
+		{input: "2023-13", wantErr: dbtypes.ErrDateOutOfRange},
+		{input: "2023-7", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "2023-07-01", wantErr: dbtypes.ErrInvalidDateFormat},
+		{input: "", wantErr: dbtypes.ErrEmptyDate},
+	}
+
+	for _, tt := range tests {
+		got, err := dbtypes.ParseYearMonth(tt.input)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseYearMonth(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("ParseYearMonth(%q) returned error: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseYearMonth(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestYearMonthArithmetic(t *testing.T) {
+	dec := dbtypes.NewYearMonth(2023, time.December)
+
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 1, want: "2024-01"},
+		{n: 2, want: "2024-02"},
+		{n: -12, want: "2022-12"},
+		{n: -11, want: "2023-01"},
+		{n: 25, want: "2026-01"},
+		{n: 0, want: "2023-12"},
+	}
+
+	for _, tt := range tests {
+		got := dec.AddMonths(tt.n)
+		if got.String() != tt.want {
+			t.Errorf("AddMonths(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+		if between := dec.MonthsBetween(got); between != abs(tt.n) {
+			t.Errorf("MonthsBetween(%s) = %d, want %d", got, between, abs(tt.n))
+		}
+	}
+
+	if got := (dbtypes.YearMonth{}).AddMonths(1); !got.IsZero() {
+		t.Errorf("zero AddMonths(1) = %s, want zero", got)
+	}
+
+	jan := dec.AddMonths(1)
+	if dec.Compare(jan) != -1 || jan.Compare(dec) != 1 || dec.Compare(dec) != 0 {
+		t.Errorf("Compare(%s, %s) is inconsistent", dec, jan)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func TestYearMonthDays(t *testing.T) {
+	tests := []struct {
+		ym    dbtypes.YearMonth
+		first string
+		last  string
+	}{
+		{ym: dbtypes.NewYearMonth(2023, time.December), first: "2023-12-01", last: "2023-12-31"},
+		{ym: dbtypes.NewYearMonth(2024, time.February), first: "2024-02-01", last: "2024-02-29"},
+		{ym: dbtypes.NewYearMonth(2023, time.February), first: "2023-02-01", last: "2023-02-28"},
+		{ym: dbtypes.YearMonth{}, first: "", last: ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ym.FirstDay().String(); got != tt.first {
+			t.Errorf("%s.FirstDay() = %s, want %s", tt.ym, got, tt.first)
+		}
+		if got := tt.ym.LastDay().String(); got != tt.last {
+			t.Errorf("%s.LastDay() = %s, want %s", tt.ym, got, tt.last)
+		}
+		if !tt.ym.IsZero() && (!tt.ym.Contains(tt.ym.LastDay()) || tt.ym.Contains(tt.ym.LastDay().AddDays(1))) {
+			t.Errorf("%s.Contains() is inconsistent with LastDay", tt.ym)
+		}
+	}
+
+	if got := dbtypes.YearMonthOf(dbtypes.NewDateUTC(2015, time.October, 21)); got.String() != "2015-10" {
+		t.Errorf("YearMonthOf(2015-10-21) = %s, want 2015-10", got)
+	}
+}
+
+func TestYearMonthScanValue(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*3600)
+
+	tests := []struct {
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{value: "2023-07", want: "2023-07"},
+		{value: []byte("2023-07"), want: "2023-07"},
+		{value: "2023-07-01", want: "2023-07"},
+		{value: time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC), want: "2023-07"},
+		{value: time.Date(2023, time.December, 31, 23, 30, 0, 0, eat), want: "2023-12"},
+		{value: nil, want: ""},
+		{value: "", want: ""},
+		{value: "2023-13", wantErr: true},
+		{value: 42, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := dbtypes.NewYearMonth(2000, time.January)
+		err := got.Scan(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && got.String() != tt.want {
+			t.Errorf("Scan(%v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+
+	if v, err := dbtypes.NewYearMonth(2023, time.July).Value(); err != nil || v != "2023-07" {
+		t.Errorf("Value() = %v, %v, want 2023-07", v, err)
+	}
+	if v, err := (dbtypes.YearMonth{}).Value(); err != nil || v != nil {
+		t.Errorf("Value() of zero = %v, %v, want nil", v, err)
+	}
+}
+
+func TestYearMonthJSON(t *testing.T) {
+	type invoice struct {
+		Period dbtypes.YearMonth `json:"period"`
+		Paid   dbtypes.YearMonth `json:"paid"`
+	}
+
+	in := invoice{Period: dbtypes.NewYearMonth(2023, time.July)}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `{"period":"2023-07","paid":null}`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var got invoice
+	if err := json.Unmarshal(data, &got); err != nil || got != in {
+		t.Errorf("json.Unmarshal(%s) = %+v, %v, want %+v", data, got, err, in)
+	}
+
+	for _, input := range []string{`"2023-13"`, `202307`, `"July"`} {
+		var ym dbtypes.YearMonth
+		if err := json.Unmarshal([]byte(input), &ym); err == nil {
+			t.Errorf("json.Unmarshal(%s) error = nil, want error", input)
+		}
+	}
+}